@@ -28,6 +28,8 @@ import (
 
 	"github.com/pokt-network/posmint/codec"
 	"github.com/pokt-network/posmint/store"
+	"github.com/pokt-network/posmint/store/snapshots"
+	"github.com/pokt-network/posmint/store/streaming"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -57,19 +59,22 @@ type BaseApp struct {
 	db          dbm.DB               // common DB backend
 	cms         sdk.CommitMultiStore // Main (uncached) state
 	router      sdk.Router           // handle any kind of message
-	queryRouter sdk.QueryRouter      // router for redirecting query calls
+	queryRouter *queryRouter         // router for redirecting query calls
 	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	// set upon LoadVersion or LoadLatestVersion.
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	anteHandler    sdk.AnteHandler    // ante handler for fee and auth
+	circuitBreaker sdk.CircuitBreaker // rejects the disabled messages
+	msgPreHandler  sdk.MsgPreHandler  // logic to run before each msg is handled
+	msgPostHandler sdk.MsgPostHandler // logic to run after each msg is handled
+	initChainer    sdk.InitChainer    // initialize state with validators and state blob
+	beginBlocker   sdk.BeginBlocker   // logic to run before any txs
+	endBlocker     sdk.EndBlocker     // logic to run after all txs, and to determine valset changes
+	addrPeerFilter sdk.PeerFilter     // filter peers by address and port
+	idPeerFilter   sdk.PeerFilter     // filter peers by node ID
+	fauxMerkleMode bool               // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// --------------------
 	// Volatile state
@@ -99,6 +104,18 @@ type BaseApp struct {
 
 	// application's version string
 	appVersion string
+
+	// takes state snapshots every configured interval (optional)
+	snapshotManager *snapshots.Manager
+
+	// streams the committed state changes of every block (optional)
+	streamingService *streaming.Service
+
+	// decode the entries of the state diffs of dry runs, by store name (optional)
+	storeDecoders map[string]sdk.StoreDecoder
+
+	// number of txs executed concurrently by DeliverTxs (optional)
+	deliverTxWorkers int
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -225,6 +242,22 @@ func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// LoadLatestVersionAndUpgrade loads the latest application version after applying
+// the store upgrades (added, renamed and deleted substores) to the multistore.
+// It will panic if called more than once on a running baseapp.
+func (app *BaseApp) LoadLatestVersionAndUpgrade(baseKey *sdk.KVStoreKey, upgrades *sdk.StoreUpgrades) error {
+	err := app.cms.LoadLatestVersionAndUpgrade(upgrades)
+	if err != nil {
+		return err
+	}
+	return app.initFromMainStore(baseKey)
+}
+
+// SnapshotManager returns the state snapshot manager of the app (nil if snapshots are disabled).
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -319,6 +352,9 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 // and deliverState is set nil on Commit().
 func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
+	if app.streamingService != nil {
+		ms = app.cms.CacheMultiStoreWithListening()
+	}
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.logger),
@@ -496,6 +532,25 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				result = app.Simulate(txBytes, tx)
 			}
 
+		case "dry_run":
+			txBytes := req.Data
+			var dryRun sdk.DryRunResult
+			tx, err := app.txDecoder(txBytes)
+			if err != nil {
+				dryRun.Result = err.Result()
+			} else {
+				dryRun = app.DryRun(txBytes, tx)
+			}
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Height:    req.Height,
+				Value:     codec.Cdc.MustMarshalBinaryLengthPrefixed(dryRun),
+			}
+
+		case "batch":
+			return handleQueryBatch(app, req)
+
 		case "version":
 			return abci.ResponseQuery{
 				Code:      uint32(sdk.CodeOK),
@@ -517,7 +572,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 		}
 	}
 
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be either simulate, dry_run, batch or version, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -571,43 +626,105 @@ func handleQueryP2P(app *BaseApp, path []string, _ abci.RequestQuery) (res abci.
 }
 
 func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res abci.ResponseQuery) {
-	// path[0] should be "custom" because "/custom" prefix is required for keeper
-	// queries.
-	//
-	// The queryRouter routes using path[1]. For example, in the path
-	// "custom/gov/proposal", queryRouter routes using "gov".
-	if len(path) < 2 || path[1] == "" {
-		return sdk.ErrUnknownRequest("No route for custom query specified").QueryResult()
+	ctx, height, err := app.createQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return err.QueryResult()
 	}
+	req.Height = height
 
-	querier := app.queryRouter.Route(path[1])
-	if querier == nil {
-		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
+	return queryCustom(app, ctx, path, req)
+}
+
+// handleQueryBatch runs the custom queries of the batch, amino encoded in the
+// data of the request, on the state at the height of the request. It returns
+// their results in order, each query failing on its own. A batch of more than
+// sdk.MaxBatchQuerySize queries is rejected.
+func handleQueryBatch(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	var queries []sdk.BatchQuery
+	if err := codec.Cdc.UnmarshalBinaryLengthPrefixed(req.Data, &queries); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("failed to decode batch query; %s", err)).QueryResult()
+	}
+	if len(queries) > sdk.MaxBatchQuerySize {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("batch of %d queries exceeds the maximum of %d", len(queries), sdk.MaxBatchQuerySize)).QueryResult()
 	}
 
+	ctx, height, err := app.createQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return err.QueryResult()
+	}
+
+	results := make([]sdk.BatchQueryResult, len(queries))
+	for i, query := range queries {
+		var res abci.ResponseQuery
+		path := splitPath(query.Path)
+		if len(path) == 0 || path[0] != "custom" {
+			res = sdk.ErrUnknownRequest(fmt.Sprintf("not a custom query path: %s", query.Path)).QueryResult()
+		} else {
+			// every query gets its own cache so that none sees the writes of another
+			queryCtx, _ := ctx.CacheContext()
+			res = queryCustom(app, queryCtx, path, abci.RequestQuery{Path: query.Path, Data: query.Data, Height: height})
+		}
+		results[i] = sdk.BatchQueryResult{
+			Code:      res.Code,
+			Codespace: res.Codespace,
+			Log:       res.Log,
+			Value:     res.Value,
+		}
+	}
+
+	return abci.ResponseQuery{
+		Code:      uint32(sdk.CodeOK),
+		Codespace: string(sdk.CodespaceRoot),
+		Height:    height,
+		Value:     codec.Cdc.MustMarshalBinaryLengthPrefixed(results),
+	}
+}
+
+// createQueryContext returns a context on a cache-wrapped copy of the state at
+// the height, the latest one when zero, along with that height.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, int64, sdk.Error) {
 	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+	if height == 0 {
+		height = app.LastBlockHeight()
 	}
 
-	if req.Height <= 1 && req.Prove {
-		return sdk.ErrInternal("cannot query with proof when height <= 1; please provide a valid height").QueryResult()
+	if height <= 1 && prove {
+		return sdk.Context{}, height, sdk.ErrInternal("cannot query with proof when height <= 1; please provide a valid height")
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
 	if err != nil {
-		return sdk.ErrInternal(
+		return sdk.Context{}, height, sdk.ErrInternal(
 			fmt.Sprintf(
 				"failed to load state at height %d; %s (latest height: %d)",
-				req.Height, err, app.LastBlockHeight(),
+				height, err, app.LastBlockHeight(),
 			),
-		).QueryResult()
+		)
 	}
 
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
 	).WithMinGasPrices(app.minGasPrices)
+	return ctx, height, nil
+}
+
+// queryCustom routes the custom query to its querier, and runs it with the
+// context.
+func queryCustom(app *BaseApp, ctx sdk.Context, path []string, req abci.RequestQuery) abci.ResponseQuery {
+	// path[0] should be "custom" because "/custom" prefix is required for keeper
+	// queries.
+	//
+	// The queryRouter routes using path[1]. For example, in the path
+	// "custom/gov/proposal", queryRouter routes using "gov".
+	if len(path) < 2 || path[1] == "" {
+		return sdk.ErrUnknownRequest("No route for custom query specified").QueryResult()
+	}
+
+	querier := app.queryRouter.Route(path[1])
+	if querier == nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
+	}
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -684,6 +801,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	if app.streamingService != nil {
+		app.streamingService.ListenBeginBlock(req.Header.Height)
+	}
 	return
 }
 
@@ -723,6 +844,15 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		result = app.runTx(runTxModeDeliver, req.Tx, tx)
 	}
 
+	if app.streamingService != nil {
+		app.streamingService.ListenDeliverTx(req.Tx, uint32(result.Code))
+	}
+
+	return deliverTxResponse(result)
+}
+
+// deliverTxResponse converts the result of a delivered tx to its ABCI response.
+func deliverTxResponse(result sdk.Result) abci.ResponseDeliverTx {
 	return abci.ResponseDeliverTx{
 		Code:      uint32(result.Code),
 		Codespace: string(result.Codespace),
@@ -787,11 +917,27 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 			return sdk.ErrUnknownRequest("unrecognized Msg type: " + msgRoute).Result()
 		}
 
+		// reject the messages disabled by the circuit breaker
+		if app.circuitBreaker != nil {
+			if err := app.circuitBreaker(ctx, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		if app.msgPreHandler != nil {
+			if err := app.msgPreHandler(ctx, msg); err != nil {
+				return err.Result()
+			}
+		}
+
 		var msgResult sdk.Result
 
 		// skip actual execution for CheckTx mode
 		if mode != runTxModeCheck {
 			msgResult = handler(ctx, msg)
+			if app.msgPostHandler != nil {
+				msgResult = app.msgPostHandler(ctx, msg, msgResult)
+			}
 		}
 
 		// Each message result's Data must be length prefixed in order to separate
@@ -862,17 +1008,24 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (
 // further details on transaction execution, reference the BaseApp SDK
 // documentation.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
+	result, _ = app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+	return result
+}
+
+// runTxWithContext processes a transaction on the given context, as returned by
+// getContextForTx for the mode. It also returns the gas meter of the tx, which
+// is the one of ctx unless the ante handler replaced it.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result, gasMeter sdk.GasMeter) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
-		return sdk.ErrOutOfGas("no block gas left to run tx").Result()
+		return sdk.ErrOutOfGas("no block gas left to run tx").Result(), ctx.GasMeter()
 	}
 
 	var startingGas uint64
@@ -897,6 +1050,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+		gasMeter = ctx.GasMeter()
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -919,7 +1073,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 	var msgs = tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return err.Result()
+		return err.Result(), ctx.GasMeter()
 	}
 
 	if app.anteHandler != nil {
@@ -950,7 +1104,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		gasWanted = result.GasWanted
 
 		if abort {
-			return result
+			return result, ctx.GasMeter()
 		}
 
 		msCache.Write()
@@ -962,9 +1116,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
 
-	// Safety check: don't write the cache state unless we're in DeliverTx.
-	if mode != runTxModeDeliver {
-		return result
+	// Safety check: don't write the cache state in CheckTx. A simulation runs on
+	// a throwaway copy of the check state, see getContextForTx, and writes to it
+	// so that its changes can be inspected by a dry run.
+	if mode == runTxModeCheck {
+		return result, ctx.GasMeter()
 	}
 
 	// only update state if all messages pass
@@ -972,7 +1128,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		msCache.Write()
 	}
 
-	return result
+	return result, ctx.GasMeter()
 }
 
 // EndBlock implements the ABCI interface.
@@ -985,6 +1141,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	if app.streamingService != nil {
+		app.streamingService.ListenEndBlock()
+	}
+
 	return
 }
 
@@ -1023,6 +1183,15 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	// the cached query results were made with the previous check state
+	app.queryRouter.PurgeCache()
+
+	if app.streamingService != nil {
+		if err := app.streamingService.ListenCommit(commitID.Hash); err != nil {
+			app.logger.Error("failed to stream committed state changes", "height", header.Height, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -1032,11 +1201,34 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	// snapshot the committed state in the background, holding it from being
+	// pruned by the next commits until the snapshot completes
+	if app.snapshotManager != nil && app.snapshotManager.ShouldSnapshot(header.Height) {
+		app.cms.HoldVersion(header.Height)
+		go app.snapshot(header.Height)
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// snapshot takes a state snapshot at the height, held from pruning, and prunes
+// the old snapshots
+func (app *BaseApp) snapshot(height int64) {
+	defer app.cms.ReleaseVersion(height)
+	app.logger.Info("creating state snapshot", "height", height)
+	snapshot, err := app.snapshotManager.Create(height)
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("completed state snapshot", "height", height, "chunks", snapshot.Chunks, "hash", fmt.Sprintf("%X", snapshot.Hash))
+	if err := app.snapshotManager.Prune(); err != nil {
+		app.logger.Error("failed to prune state snapshots", "err", err)
+	}
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
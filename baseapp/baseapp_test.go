@@ -3,6 +3,7 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
@@ -17,7 +18,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store/streaming"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/types/module"
 )
 
 var (
@@ -687,6 +690,126 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+// A tripped circuit breaker rejects the msg before it reaches its handler.
+func TestCircuitBreaker(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	deliverKey2 := []byte("deliver-key2")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(routeMsgCounter2, handlerMsgCounter(t, capKey1, deliverKey2))
+	}
+	breakerOpt := func(bapp *BaseApp) {
+		bapp.SetCircuitBreaker(func(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+			if msg.Route() == routeMsgCounter2 {
+				return sdk.ErrUnauthorized("circuit tripped")
+			}
+			return nil
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt, breakerOpt)
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	tx = newTxCounter(1, 1)
+	tx.Msgs = append(tx.Msgs, msgCounter2{0})
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdk.CodeUnauthorized, sdk.CodeType(res.Code))
+
+	// the whole tx is rolled back
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey2))
+}
+
+func TestMsgPrePostHandlers(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	deliverKey2 := []byte("deliver-key2")
+	preGas := uint64(7)
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(routeMsgCounter2, handlerMsgCounter(t, capKey1, deliverKey2))
+	}
+	var preCalls, postCalls []string
+	middlewareOpt := func(bapp *BaseApp) {
+		bapp.SetMsgPreHandlers(
+			func(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+				preCalls = append(preCalls, msg.Route())
+				ctx.GasMeter().ConsumeGas(preGas, "msg fee")
+				return nil
+			},
+			func(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+				if msg.Route() == routeMsgCounter2 {
+					return sdk.ErrUnauthorized("unauthorized msg")
+				}
+				return nil
+			},
+		)
+		bapp.SetMsgPostHandlers(func(ctx sdk.Context, msg sdk.Msg, result sdk.Result) sdk.Result {
+			postCalls = append(postCalls, msg.Route())
+			result.Events = result.Events.AppendEvent(sdk.NewEvent("post", sdk.NewAttribute("route", msg.Route())))
+			return result
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt, middlewareOpt)
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.True(t, res.GasUsed >= int64(preGas))
+	require.Equal(t, "post", res.Events[len(res.Events)-1].Type)
+	require.Equal(t, []string{routeMsgCounter}, preCalls)
+	require.Equal(t, []string{routeMsgCounter}, postCalls)
+
+	// the pre handlers run in CheckTx but the post handlers don't
+	preCalls, postCalls = nil, nil
+	tx = newTxCounter(1, 0)
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	chk := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, chk.IsOK(), fmt.Sprintf("%v", chk))
+	require.Equal(t, []string{routeMsgCounter}, preCalls)
+	require.Empty(t, postCalls)
+
+	// a rejected msg fails and rolls back the whole tx
+	preCalls, postCalls = nil, nil
+	tx = newTxCounter(1, 1)
+	tx.Msgs = append(tx.Msgs, msgCounter2{0})
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdk.CodeUnauthorized, sdk.CodeType(res.Code))
+	require.Equal(t, []string{routeMsgCounter, routeMsgCounter2}, preCalls)
+	require.Equal(t, []string{routeMsgCounter}, postCalls)
+
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey2))
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
@@ -760,6 +883,168 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+func TestDryRunTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			if m, ok := msg.(msgCounter); ok && m.FailOnHandler {
+				return sdk.ErrInternal("message handler failure").Result()
+			}
+			ctx.KVStore(capKey1).Set(deliverKey, []byte{1})
+			ctx.KVStore(capKey2).Delete(deliverKey)
+			return sdk.Result{}
+		})
+	}
+	decoderOpt := func(bapp *BaseApp) {
+		bapp.SetStoreDecoders(map[string]sdk.StoreDecoder{
+			capKey1.Name(): func(key, value []byte) (string, json.RawMessage, bool) {
+				if !bytes.Equal(key, deliverKey) {
+					return "", nil, false
+				}
+				return "delivered", json.RawMessage(fmt.Sprintf("%d", value[0])), true
+			},
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, decoderOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// commit a value deleted by the tx
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.deliverState.ctx.KVStore(capKey2).Set(deliverKey, []byte{2})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	queryResult := app.Query(abci.RequestQuery{Path: "/app/dry_run", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+	var res sdk.DryRunResult
+	codec.Cdc.MustUnmarshalBinaryLengthPrefixed(queryResult.Value, &res)
+	require.True(t, res.Result.IsOK(), res.Result.Log)
+
+	require.Len(t, res.Diff, 3)
+	require.Equal(t, capKey1.Name(), res.Diff[0].StoreKey)
+	require.Equal(t, anteKey, res.Diff[0].Key)
+	require.Nil(t, res.Diff[0].Before)
+	require.Empty(t, res.Diff[0].Type)
+
+	require.Equal(t, capKey1.Name(), res.Diff[1].StoreKey)
+	require.Equal(t, deliverKey, res.Diff[1].Key)
+	require.Equal(t, []byte{1}, res.Diff[1].After)
+	require.Equal(t, "delivered", res.Diff[1].Type)
+	require.Equal(t, json.RawMessage("1"), res.Diff[1].DecodedNew)
+
+	require.Equal(t, capKey2.Name(), res.Diff[2].StoreKey)
+	require.Equal(t, []byte{2}, res.Diff[2].Before)
+	require.True(t, res.Diff[2].Deleted())
+
+	// nothing is written to the check state
+	store := app.checkState.ctx.KVStore(capKey1)
+	require.Nil(t, store.Get(anteKey))
+	require.Nil(t, store.Get(deliverKey))
+	require.Equal(t, []byte{2}, app.checkState.ctx.KVStore(capKey2).Get(deliverKey))
+
+	// the changes of the ante handler remain when the msgs fail
+	tx.setFailOnHandler(true)
+	dryRun := app.DryRun(nil, *tx)
+	require.False(t, dryRun.Result.IsOK())
+	require.Len(t, dryRun.Diff, 1)
+	require.Equal(t, anteKey, dryRun.Diff[0].Key)
+}
+
+// Test that delivering the txs of a block concurrently gives the same responses
+// and app hash as delivering them one by one, with txs that conflict through
+// the keys they read, iterate and write, and txs that run out of block gas.
+func TestParallelDeliverTxs(t *testing.T) {
+	sumKey := []byte("sum")
+	// the ante handler consumes the tx counter as gas, from a meter of its own
+	// unless sharedMeter is set
+	anteOpt := func(sharedMeter bool) func(*BaseApp) {
+		return func(bapp *BaseApp) {
+			bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+				newCtx = ctx
+				if !sharedMeter {
+					newCtx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+				}
+				newCtx.GasMeter().ConsumeGas(uint64(tx.(txTest).Counter), "counter-ante")
+				return newCtx, sdk.Result{GasWanted: 100}, false
+			})
+		}
+	}
+	// counters below 10 increment their own key, 10 stores the sum of all the
+	// keys, and 11 fails
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(5, "counter-handler")
+			store := ctx.KVStore(capKey1)
+			switch counter := msg.(*msgCounter).Counter; counter {
+			case 10:
+				var sum int64
+				iter := store.Iterator([]byte{0}, []byte{10})
+				for ; iter.Valid(); iter.Next() {
+					sum += getIntFromStore(store, iter.Key())
+				}
+				iter.Close()
+				setIntOnStore(store, sumKey, sum)
+			case 11:
+				return sdk.ErrInternal("message handler failure").Result()
+			default:
+				key := []byte{byte(counter)}
+				setIntOnStore(store, key, getIntFromStore(store, key)+1)
+			}
+			return sdk.Result{}
+		})
+	}
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	var reqs []abci.RequestDeliverTx
+	for _, counter := range []int64{1, 2, 1, 3, 10, 11, 4, 2, 10, 5, 6, 7, 1, 8, 9} {
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(newTxCounter(3, counter))
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+	reqs = append(reqs, abci.RequestDeliverTx{Tx: []byte("not a tx")})
+
+	for _, sharedMeter := range []bool{false, true} {
+		serialApp := setupBaseApp(t, anteOpt(sharedMeter), routerOpt)
+		parallelApp := setupBaseApp(t, anteOpt(sharedMeter), routerOpt, func(bapp *BaseApp) {
+			bapp.SetParallelDeliverTx(4)
+		})
+		for _, app := range []*BaseApp{serialApp, parallelApp} {
+			app.InitChain(abci.RequestInitChain{
+				ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 45000}},
+			})
+		}
+
+		for height := int64(1); height <= 3; height++ {
+			header := abci.Header{Height: height}
+			serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			var expected []abci.ResponseDeliverTx
+			for _, req := range reqs {
+				expected = append(expected, serialApp.DeliverTx(req))
+			}
+			require.Equal(t, expected, parallelApp.DeliverTxs(reqs), "shared meter: %v, height: %d", sharedMeter, height)
+			require.Equal(t, sdk.CodeOutOfGas, sdk.CodeType(expected[len(expected)-2].Code))
+
+			serialApp.EndBlock(abci.RequestEndBlock{})
+			parallelApp.EndBlock(abci.RequestEndBlock{})
+			require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data)
+		}
+	}
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -1230,6 +1515,81 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that a batch query runs its custom queries on the state of one height
+func TestBatchQuery(t *testing.T) {
+	key := []byte("key")
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("test", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			if len(path) == 0 || path[0] != "value" {
+				return nil, sdk.ErrUnknownRequest("unknown test query")
+			}
+			store := ctx.KVStore(capKey1)
+			value := store.Get(req.Data)
+			// the writes of a query are seen by no other query
+			store.Set(req.Data, []byte("written by query"))
+			return value, nil
+		})
+	}
+	app := setupBaseApp(t, querierOpt, SetPruning(store.PruneNothing), SetQueryCache(10))
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey1).Set(key, []byte{byte(height)})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	batch := func(height int64, queries ...sdk.BatchQuery) (int64, []sdk.BatchQueryResult) {
+		res := app.Query(abci.RequestQuery{
+			Path:   "/app/batch",
+			Data:   codec.Cdc.MustMarshalBinaryLengthPrefixed(queries),
+			Height: height,
+		})
+		require.True(t, res.IsOK(), res.Log)
+		var results []sdk.BatchQueryResult
+		codec.Cdc.MustUnmarshalBinaryLengthPrefixed(res.Value, &results)
+		require.Len(t, results, len(queries))
+		return res.Height, results
+	}
+
+	valueQuery := sdk.BatchQuery{Path: "/custom/test/value", Data: key}
+	height, results := batch(0, valueQuery, valueQuery,
+		sdk.BatchQuery{Path: "/custom/test/other"},
+		sdk.BatchQuery{Path: "/custom/none"},
+		sdk.BatchQuery{Path: "/store/key1/key", Data: key},
+	)
+	require.Equal(t, int64(2), height)
+	require.True(t, results[0].IsOK())
+	require.Equal(t, []byte{2}, results[0].Value)
+	require.Equal(t, results[0], results[1])
+	for _, result := range results[2:] {
+		require.False(t, result.IsOK())
+		require.Equal(t, uint32(sdk.CodeUnknownRequest), result.Code)
+	}
+
+	height, results = batch(1, valueQuery)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, []byte{1}, results[0].Value)
+
+	// a batch that can't be decoded fails as a whole
+	res := app.Query(abci.RequestQuery{Path: "/app/batch", Data: []byte("not a batch")})
+	require.False(t, res.IsOK())
+
+	// so does a batch of too many queries
+	queries := make([]sdk.BatchQuery, sdk.MaxBatchQuerySize)
+	for i := range queries {
+		queries[i] = valueQuery
+	}
+	_, results = batch(0, queries...)
+	require.Len(t, results, sdk.MaxBatchQuerySize)
+	res = app.Query(abci.RequestQuery{
+		Path: "/app/batch",
+		Data: codec.Cdc.MustMarshalBinaryLengthPrefixed(append(queries, valueQuery)),
+	})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -1276,3 +1636,121 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
 	require.Panics(t, func() { app.getMaximumBlockGas() })
 }
+
+// Test that only the committed state changes of the deliver state are streamed,
+// grouped by the ABCI call that made them.
+func TestStreaming(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginKey := []byte("begin-key")
+
+	sink := streaming.NewChannelSink(2)
+	options := []func(*BaseApp){
+		SetStreaming(streaming.NewService(sink), capKey1, capKey2),
+		func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) },
+		func(bapp *BaseApp) { bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)) },
+		func(bapp *BaseApp) {
+			bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				ctx.KVStore(capKey2).Set(beginKey, []byte{1})
+				return abci.ResponseBeginBlock{}
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+				ctx.KVStore(capKey2).Delete(beginKey)
+				return abci.ResponseEndBlock{}
+			})
+		},
+	}
+	app := setupBaseApp(t, options...)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	deliver := func(tx *txTest) {
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	// the writes of check txs are never streamed
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+
+	tx := newTxCounter(0, 0)
+	tx.setFailOnAnte(true)
+	deliver(tx)
+	tx = newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	deliver(tx)
+	deliver(newTxCounter(1, 0))
+	app.EndBlock(abci.RequestEndBlock{})
+	require.Empty(t, sink.Blocks(), "blocks are only streamed on commit")
+	commitID := app.Commit()
+
+	block := <-sink.Blocks()
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, commitID.Data, block.AppHash)
+	require.Equal(t, []sdk.StoreKVPair{{StoreKey: "key2", Key: beginKey, Value: []byte{1}}}, block.BeginBlock)
+	require.Len(t, block.Txs, 3)
+	// a tx failing the ante handler has no changes
+	require.NotZero(t, block.Txs[0].Code)
+	require.Empty(t, block.Txs[0].Changes)
+	// the changes of a failing message are discarded, the ones of the ante handler are kept
+	require.NotZero(t, block.Txs[1].Code)
+	require.Equal(t, []sdk.StoreKVPair{{StoreKey: "key1", Key: anteKey, Value: []byte{2}}}, block.Txs[1].Changes)
+	require.Zero(t, block.Txs[2].Code)
+	require.Equal(t, uint32(2), block.Txs[2].Index)
+	require.Equal(t, []sdk.StoreKVPair{
+		{StoreKey: "key1", Key: anteKey, Value: []byte{4}},
+		{StoreKey: "key1", Key: deliverKey, Value: []byte{2}},
+	}, block.Txs[2].Changes)
+	require.Equal(t, []sdk.StoreKVPair{{StoreKey: "key2", Key: beginKey, Delete: true}}, block.EndBlock)
+}
+
+// genesis module exporting the counter of capKey1
+type counterGenesisModule struct{}
+
+func (counterGenesisModule) Name() string                          { return "counter" }
+func (counterGenesisModule) RegisterCodec(*codec.Codec)            {}
+func (counterGenesisModule) DefaultGenesis() json.RawMessage       { return nil }
+func (counterGenesisModule) ValidateGenesis(json.RawMessage) error { return nil }
+func (counterGenesisModule) InitGenesis(sdk.Context, json.RawMessage) []abci.ValidatorUpdate {
+	return nil
+}
+func (counterGenesisModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf("%d", getIntFromStore(ctx.KVStore(capKey1), []byte("counter"))))
+}
+func (counterGenesisModule) PrepareForZeroHeightGenesis(ctx sdk.Context) {
+	setIntOnStore(ctx.KVStore(capKey1), []byte("counter"), 0)
+}
+
+func TestExportAppState(t *testing.T) {
+	app := setupBaseApp(t, SetPruning(store.PruneNothing))
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		setIntOnStore(app.deliverState.ctx.KVStore(capKey1), []byte("counter"), height*10)
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+	mm := module.NewManager(module.NewGenesisOnlyAppModule(counterGenesisModule{}))
+
+	export := func(height int64, forZeroHeight bool) string {
+		appState, err := app.ExportAppState(mm, height, forZeroHeight)
+		require.NoError(t, err)
+		var genesis map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appState, &genesis))
+		return string(genesis["counter"])
+	}
+	require.Equal(t, "20", export(0, false), "height 0 exports the latest state")
+	require.Equal(t, "10", export(1, false))
+	require.Equal(t, "0", export(1, true))
+	// the committed state is untouched by a zero height export
+	require.Equal(t, "10", export(1, false))
+
+	_, err := app.ExportAppState(mm, 3, false)
+	require.Error(t, err)
+}
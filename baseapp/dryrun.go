@@ -0,0 +1,98 @@
+package baseapp
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pokt-network/posmint/store/cachemulti"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// DryRun simulates the tx on a throwaway copy of the check state, like
+// Simulate, and returns the changes it would make to the state along with its
+// result. The writes to transient stores are left out as they never persist.
+func (app *BaseApp) DryRun(txBytes []byte, tx sdk.Tx) sdk.DryRunResult {
+	recorder := newDiffRecorder()
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+	ctx = ctx.WithMultiStore(ctx.MultiStore().(cachemulti.Store).AddListenerToAll(recorder))
+
+	// the msgs of a failed tx change nothing, but its ante handler may have (e.g. fees)
+	result, _ := app.runTxWithContext(ctx, runTxModeSimulate, txBytes, tx)
+	return sdk.DryRunResult{Result: result, Diff: recorder.diff(app.checkState.ms, app.storeDecoders)}
+}
+
+// diffRecorder records the last value written to each key of the listened
+// stores, nil for a delete.
+type diffRecorder struct {
+	stores map[string]sdk.StoreKey
+	writes map[string]map[string][]byte
+}
+
+var _ sdk.WriteListener = (*diffRecorder)(nil)
+
+func newDiffRecorder() *diffRecorder {
+	return &diffRecorder{
+		stores: make(map[string]sdk.StoreKey),
+		writes: make(map[string]map[string][]byte),
+	}
+}
+
+// Implements WriteListener.
+func (r *diffRecorder) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) {
+	name := storeKey.Name()
+	if _, ok := r.writes[name]; !ok {
+		r.stores[name] = storeKey
+		r.writes[name] = make(map[string][]byte)
+	}
+	if delete {
+		value = nil
+	} else {
+		value = append([]byte{}, value...)
+	}
+	r.writes[name][string(key)] = value
+}
+
+// diff compares the recorded writes with the values of base, and decodes the
+// changed entries with the decoder of their store, if any.
+func (r *diffRecorder) diff(base sdk.MultiStore, decoders map[string]sdk.StoreDecoder) sdk.StateDiff {
+	diff := sdk.StateDiff{}
+	for name, writes := range r.writes {
+		store := base.GetKVStore(r.stores[name])
+		if store.GetStoreType() == sdk.StoreTypeTransient {
+			continue
+		}
+		decode := decoders[name]
+		for key, after := range writes {
+			before := store.Get([]byte(key))
+			if bytes.Equal(before, after) && (before == nil) == (after == nil) {
+				continue
+			}
+			change := sdk.StateChange{
+				StoreKey: name,
+				Key:      []byte(key),
+				Before:   before,
+				After:    after,
+			}
+			if decode != nil {
+				if before != nil {
+					if typ, decoded, ok := decode(change.Key, before); ok {
+						change.Type, change.DecodedOld = typ, decoded
+					}
+				}
+				if after != nil {
+					if typ, decoded, ok := decode(change.Key, after); ok {
+						change.Type, change.DecodedNew = typ, decoded
+					}
+				}
+			}
+			diff = append(diff, change)
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		if diff[i].StoreKey != diff[j].StoreKey {
+			return diff[i].StoreKey < diff[j].StoreKey
+		}
+		return bytes.Compare(diff[i].Key, diff[j].Key) < 0
+	})
+	return diff
+}
@@ -0,0 +1,37 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/types/module"
+)
+
+// ExportAppState exports the genesis state of every module of mm, in export
+// genesis order, from the state committed at height (the latest if 0). The
+// height must still be retained by the pruning strategy of the store.
+//
+// With forZeroHeight, the modules implementing module.ZeroHeightGenesisPreparer
+// first adjust the exported state (e.g. signing info, unstaking queues and jail
+// terms) so that it can be used to relaunch a new chain from height zero. The
+// committed state is never modified.
+func (app *BaseApp) ExportAppState(mm *module.Manager, height int64, forZeroHeight bool) (json.RawMessage, error) {
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	if height < 0 || height > app.LastBlockHeight() {
+		return nil, fmt.Errorf("cannot export height %d; latest height: %d", height, app.LastBlockHeight())
+	}
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight())
+	}
+	ctx := sdk.NewContext(cacheMS, abci.Header{Height: height}, false, app.logger)
+	if forZeroHeight {
+		mm.PrepareForZeroHeightGenesis(ctx)
+	}
+	return json.MarshalIndent(mm.ExportGenesis(ctx), "", "  ")
+}
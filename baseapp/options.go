@@ -7,6 +7,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pokt-network/posmint/store"
+	"github.com/pokt-network/posmint/store/snapshots"
+	"github.com/pokt-network/posmint/store/streaming"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -38,6 +40,30 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetSnapshots returns a BaseApp option function that snapshots the multistore
+// into dir every interval heights, keeping the keepRecent latest snapshots.
+// A snapshot height is held from pruning until its snapshot completes.
+func SetSnapshots(dir string, interval int64, keepRecent uint32) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.snapshotManager = snapshots.NewManager(dir, bap.cms, interval, keepRecent) }
+}
+
+// SetQueryCache returns a BaseApp option function that caches the results of
+// the size most recently made custom queries, until the next commit.
+func SetQueryCache(size int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.queryRouter.SetCacheSize(size) }
+}
+
+// SetStreaming returns a BaseApp option function that streams the committed
+// changes of the stores of keys to the sinks of service.
+func SetStreaming(service *streaming.Service, keys ...sdk.StoreKey) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		for _, key := range keys {
+			bap.cms.AddListeners(key, []sdk.WriteListener{service})
+		}
+		bap.streamingService = service
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -95,6 +121,52 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+func (app *BaseApp) SetCircuitBreaker(cb sdk.CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+	app.circuitBreaker = cb
+}
+
+// SetStoreDecoders sets the decoders of the store entries changed by a dry run,
+// by store name.
+func (app *BaseApp) SetStoreDecoders(decoders map[string]sdk.StoreDecoder) {
+	if app.sealed {
+		panic("SetStoreDecoders() on sealed BaseApp")
+	}
+	app.storeDecoders = decoders
+}
+
+// SetParallelDeliverTx sets the number of txs executed concurrently by
+// DeliverTxs, which delivers them one by one when workers is less than 2. The
+// ante handler, msg handlers and hooks of the app must then be safe for
+// concurrent use, and keep all their state in the stores. It has no effect on
+// the txs delivered by Tendermint through DeliverTx, only on DeliverTxs.
+func (app *BaseApp) SetParallelDeliverTx(workers int) {
+	if app.sealed {
+		panic("SetParallelDeliverTx() on sealed BaseApp")
+	}
+	app.deliverTxWorkers = workers
+}
+
+// SetMsgPreHandlers sets the handlers run, in order, before each msg of a tx is
+// routed to its module handler, in every run mode.
+func (app *BaseApp) SetMsgPreHandlers(handlers ...sdk.MsgPreHandler) {
+	if app.sealed {
+		panic("SetMsgPreHandlers() on sealed BaseApp")
+	}
+	app.msgPreHandler = sdk.ChainMsgPreHandlers(handlers...)
+}
+
+// SetMsgPostHandlers sets the handlers run, in order, after each msg of a tx has
+// been handled. They are skipped in CheckTx, where msgs are not executed.
+func (app *BaseApp) SetMsgPostHandlers(handlers ...sdk.MsgPostHandler) {
+	if app.sealed {
+		panic("SetMsgPostHandlers() on sealed BaseApp")
+	}
+	app.msgPostHandler = sdk.ChainMsgPostHandlers(handlers...)
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,191 @@
+package baseapp
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/pokt-network/posmint/store/cachemulti"
+	"github.com/pokt-network/posmint/store/rwset"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// DeliverTxs delivers the txs of a block in order, and returns their responses.
+//
+// With parallel execution enabled, see SetParallelDeliverTx, the txs are first
+// executed concurrently, each on its own branch of the deliver state, recording
+// the keys they read and write. Then they are committed in order: a tx that
+// read a key written by an earlier tx of the block, or whose gas can't be
+// accounted as it would have been, is executed again on the updated deliver
+// state. So the responses and the resulting state are the same as delivering
+// the txs one by one with DeliverTx.
+//
+// Tendermint delivers the txs of a block one at a time through the ABCI
+// DeliverTx, so a node driven by Tendermint never calls DeliverTxs. It is the
+// entry point of the callers holding a whole block, such as a tool replaying
+// the blocks of a block store against the app, which call it between
+// BeginBlock and EndBlock in place of DeliverTx.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+	if !app.parallelDeliverTxEnabled() {
+		for i, req := range reqs {
+			responses[i] = app.DeliverTx(req)
+		}
+		return responses
+	}
+
+	// execute the txs concurrently on branches of the deliver state
+	executions := make([]*txExecution, len(reqs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < app.deliverTxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				executions[i] = app.executeTx(reqs[i].Tx, true)
+			}
+		}()
+	}
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// commit them in order, executing again the ones that conflict
+	written := make(map[sdk.StoreKey]map[string]struct{})
+	for i, req := range reqs {
+		execution := executions[i]
+		// a tx that can't be decoded is rejected as by DeliverTx
+		result, committed := execution.result, execution.decodeErr != nil
+		if !committed && !execution.conflicts(written) {
+			result, committed = app.commitParallelTx(execution)
+		}
+		if !committed {
+			execution = app.executeTx(req.Tx, false)
+			execution.branch.Write()
+			result = execution.result
+		}
+		execution.addWrites(written)
+
+		if app.streamingService != nil {
+			app.streamingService.ListenDeliverTx(req.Tx, uint32(result.Code))
+		}
+		responses[i] = deliverTxResponse(result)
+	}
+	return responses
+}
+
+// txs are only executed concurrently on the branches of a deliver state that
+// isn't traced, as the trace writer is shared
+func (app *BaseApp) parallelDeliverTxEnabled() bool {
+	if app.deliverTxWorkers < 2 || app.deliverState.ms.TracingEnabled() {
+		return false
+	}
+	_, ok := app.deliverState.ms.(cachemulti.Store)
+	return ok
+}
+
+// txExecution is a tx executed on a branch of the deliver state, with the keys
+// it read and wrote in each store.
+type txExecution struct {
+	decodeErr     sdk.Error
+	result        sdk.Result
+	branch        sdk.CacheMultiStore
+	sets          map[sdk.StoreKey]*rwset.Set
+	gasMeter      sdk.GasMeter // gas meter of the tx context, replaced for a concurrent execution
+	blockGasMeter sdk.GasMeter // block gas meter of the tx context, replaced for a concurrent execution
+	txGasMeter    sdk.GasMeter // gas meter of the tx after its execution
+	eventManager  *sdk.EventManager
+}
+
+// executeTx executes the tx on a new branch of the deliver state. A concurrent
+// execution gets its own gas meters and event manager, as the ones of the
+// deliver state can't be shared.
+func (app *BaseApp) executeTx(txBytes []byte, concurrent bool) *txExecution {
+	execution := &txExecution{sets: make(map[sdk.StoreKey]*rwset.Set)}
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		execution.decodeErr = err
+		execution.result = err.Result()
+		return execution
+	}
+
+	execution.branch = app.deliverState.ms.(cachemulti.Store).CacheMultiStoreWithWrapper(
+		func(key sdk.StoreKey, parent sdk.KVStore) sdk.KVStore {
+			set := rwset.NewSet()
+			execution.sets[key] = set
+			return rwset.NewStore(parent, set)
+		},
+	)
+	ctx := app.getContextForTx(runTxModeDeliver, txBytes).WithMultiStore(execution.branch)
+	if concurrent {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).
+			WithBlockGasMeter(sdk.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+	}
+	execution.gasMeter, execution.blockGasMeter = ctx.GasMeter(), ctx.BlockGasMeter()
+	execution.eventManager = ctx.EventManager()
+	execution.result, execution.txGasMeter = app.runTxWithContext(ctx, runTxModeDeliver, txBytes, tx)
+	return execution
+}
+
+// conflicts returns true if the execution read any of the written keys.
+func (e *txExecution) conflicts(written map[sdk.StoreKey]map[string]struct{}) bool {
+	for key, set := range e.sets {
+		if set.ReadsAny(written[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// addWrites adds the keys written by the execution to written.
+func (e *txExecution) addWrites(written map[sdk.StoreKey]map[string]struct{}) {
+	for key, set := range e.sets {
+		if len(set.Writes) == 0 {
+			continue
+		}
+		if written[key] == nil {
+			written[key] = make(map[string]struct{})
+		}
+		for k := range set.Writes {
+			written[key][k] = struct{}{}
+		}
+	}
+}
+
+// commitParallelTx writes the branch of a tx executed concurrently to the
+// deliver state, consumes its gas from the gas meters of the deliver state as
+// runTx would have, and emits the events it emitted outside of its handlers.
+// It returns false, without committing anything, if the result of the tx would
+// have been different: when it ran out of block gas, or when it used the gas
+// meter of the deliver state, whose consumption is reported in its result.
+func (app *BaseApp) commitParallelTx(execution *txExecution) (sdk.Result, bool) {
+	if execution.txGasMeter == execution.gasMeter {
+		return sdk.Result{}, false
+	}
+	ctx := app.deliverState.ctx
+	gas, blockGas := execution.gasMeter.GasConsumed(), execution.blockGasMeter.GasConsumed()
+	if ctx.BlockGasMeter().IsOutOfGas() || !canConsumeGas(ctx.GasMeter(), gas) ||
+		!canConsumeGas(ctx.BlockGasMeter(), blockGas) {
+		return sdk.Result{}, false
+	}
+
+	execution.branch.Write()
+	ctx.GasMeter().ConsumeGas(gas, "parallel tx")
+	ctx.BlockGasMeter().ConsumeGas(blockGas, "block gas meter")
+	ctx.EventManager().EmitEvents(execution.eventManager.Events())
+	return execution.result, true
+}
+
+// returns true if the meter can consume gas without running out of gas or
+// overflowing
+func canConsumeGas(meter sdk.GasMeter, gas uint64) bool {
+	consumed := meter.GasConsumed()
+	if consumed+gas < consumed {
+		return false
+	}
+	return meter.Limit() == 0 || consumed+gas <= meter.Limit()
+}
@@ -1,13 +1,19 @@
 package baseapp
 
 import (
+	"container/list"
 	"fmt"
+	"strings"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/pokt-network/posmint/types"
 )
 
 type queryRouter struct {
 	routes map[string]sdk.Querier
+	cache  *queryCache // results of the queriers, nil unless enabled
 }
 
 var _ sdk.QueryRouter = NewQueryRouter()
@@ -35,7 +41,101 @@ func (qrt *queryRouter) AddRoute(path string, q sdk.Querier) sdk.QueryRouter {
 	return qrt
 }
 
-// Route returns the Querier for a given query route path.
+// Route returns the Querier for a given query route path. When the cache is
+// enabled, the Querier returns the cached result of a query already made with
+// the same path, data and height.
 func (qrt *queryRouter) Route(path string) sdk.Querier {
-	return qrt.routes[path]
+	q := qrt.routes[path]
+	if q == nil || qrt.cache == nil {
+		return q
+	}
+	return qrt.cache.wrap(path, q)
+}
+
+// SetCacheSize enables a cache of the size most recently used query results,
+// or disables it when size is not positive.
+func (qrt *queryRouter) SetCacheSize(size int) {
+	if size <= 0 {
+		qrt.cache = nil
+		return
+	}
+	qrt.cache = newQueryCache(size)
+}
+
+// PurgeCache empties the cache, if enabled.
+func (qrt *queryRouter) PurgeCache() {
+	if qrt.cache != nil {
+		qrt.cache.purge()
+	}
+}
+
+// queryCache is an LRU cache of the results of successful queries, keyed by
+// path, data and height. It is safe for concurrent use.
+type queryCache struct {
+	mtx     sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List // most recently used first
+}
+
+type queryCacheEntry struct {
+	key   string
+	value []byte
+}
+
+func newQueryCache(size int) *queryCache {
+	return &queryCache{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		lru:     list.New(),
+	}
+}
+
+// wrap returns a Querier that caches the results of the querier of the route.
+func (c *queryCache) wrap(route string, q sdk.Querier) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		key := fmt.Sprintf("%d/%q/%X", req.Height, route+"/"+strings.Join(path, "/"), req.Data)
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+		value, err := q(ctx, path, req)
+		if err == nil {
+			c.add(key, value)
+		}
+		return value, err
+	}
+}
+
+func (c *queryCache) get(key string) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*queryCacheEntry).value, true
+}
+
+func (c *queryCache) add(key string, value []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*queryCacheEntry).value = value
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&queryCacheEntry{key: key, value: value})
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*queryCacheEntry).key)
+	}
+}
+
+func (c *queryCache) purge() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.entries = make(map[string]*list.Element, c.size)
+	c.lru.Init()
 }
@@ -31,3 +31,51 @@ func TestQueryRouter(t *testing.T) {
 		qr.AddRoute("testRoute", testQuerier)
 	})
 }
+
+func TestQueryRouterCache(t *testing.T) {
+	calls := 0
+	qr := NewQueryRouter()
+	qr.AddRoute("testRoute", func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		calls++
+		if len(path) > 0 && path[0] == "fail" {
+			return nil, sdk.ErrUnknownRequest("failed")
+		}
+		return append([]byte(path[0]), req.Data...), nil
+	})
+	query := func(path string, data []byte, height int64) []byte {
+		res, _ := qr.Route("testRoute")(sdk.Context{}, []string{path}, abci.RequestQuery{Data: data, Height: height})
+		return res
+	}
+
+	// without a cache every query runs the querier
+	query("a", nil, 1)
+	query("a", nil, 1)
+	require.Equal(t, 2, calls)
+
+	qr.SetCacheSize(2)
+	calls = 0
+	require.Equal(t, []byte("a"), query("a", nil, 1))
+	require.Equal(t, []byte("a"), query("a", nil, 1))
+	require.Equal(t, 1, calls)
+
+	// the path, data and height are all part of the key
+	require.Equal(t, []byte("ab"), query("a", []byte("b"), 1))
+	query("a", nil, 2)
+	require.Equal(t, 3, calls)
+
+	// the least recently used result has been evicted
+	query("a", nil, 2)
+	query("a", []byte("b"), 1)
+	require.Equal(t, 3, calls)
+	query("a", nil, 1)
+	require.Equal(t, 4, calls)
+
+	// failed queries are not cached
+	query("fail", nil, 1)
+	query("fail", nil, 1)
+	require.Equal(t, 6, calls)
+
+	qr.PurgeCache()
+	query("a", nil, 1)
+	require.Equal(t, 7, calls)
+}
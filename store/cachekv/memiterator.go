@@ -1,42 +1,51 @@
 package cachekv
 
 import (
-	"container/list"
-
-	cmn "github.com/tendermint/tendermint/libs/common"
-	dbm "github.com/tendermint/tm-db"
+	"bytes"
 )
 
-// Iterates over iterKVCache items.
-// if key is nil, means it was deleted.
+// Iterates over the sorted cache items in a domain.
+// if value is nil, means it was deleted.
 // Implements Iterator.
 type memIterator struct {
 	start, end []byte
-	items      []*cmn.KVPair
 	ascending  bool
+	// the path to the current item; the top of the stack is the current item
+	stack []*kvNode
+	valid bool
 }
 
-func newMemIterator(start, end []byte, items *list.List, ascending bool) *memIterator {
-	itemsInDomain := make([]*cmn.KVPair, 0)
-	var entered bool
-	for e := items.Front(); e != nil; e = e.Next() {
-		item := e.Value.(*cmn.KVPair)
-		if !dbm.IsKeyInDomain(item.Key, start, end) {
-			if entered {
-				break
-			}
-			continue
-		}
-		itemsInDomain = append(itemsInDomain, item)
-		entered = true
-	}
-
-	return &memIterator{
+// newMemIterator seeks to the first item of the domain in O(log n).
+func newMemIterator(start, end []byte, root *kvNode, ascending bool) *memIterator {
+	mi := &memIterator{
 		start:     start,
 		end:       end,
-		items:     itemsInDomain,
 		ascending: ascending,
 	}
+	mi.push(root)
+	mi.updateValid()
+	return mi
+}
+
+// pushes the path from n to the first item of its subtree in iteration order
+func (mi *memIterator) push(n *kvNode) {
+	for n != nil {
+		if mi.ascending {
+			if mi.start != nil && bytes.Compare(n.key, mi.start) < 0 {
+				n = n.right
+				continue
+			}
+			mi.stack = append(mi.stack, n)
+			n = n.left
+		} else {
+			if mi.end != nil && bytes.Compare(n.key, mi.end) >= 0 {
+				n = n.left
+				continue
+			}
+			mi.stack = append(mi.stack, n)
+			n = n.right
+		}
+	}
 }
 
 func (mi *memIterator) Domain() ([]byte, []byte) {
@@ -44,7 +53,21 @@ func (mi *memIterator) Domain() ([]byte, []byte) {
 }
 
 func (mi *memIterator) Valid() bool {
-	return len(mi.items) > 0
+	return mi.valid
+}
+
+// the iterator is valid while the current item is in the domain
+func (mi *memIterator) updateValid() {
+	if len(mi.stack) == 0 {
+		mi.valid = false
+		return
+	}
+	key := mi.stack[len(mi.stack)-1].key
+	if mi.ascending {
+		mi.valid = mi.end == nil || bytes.Compare(key, mi.end) < 0
+	} else {
+		mi.valid = mi.start == nil || bytes.Compare(key, mi.start) >= 0
+	}
 }
 
 func (mi *memIterator) assertValid() {
@@ -55,31 +78,29 @@ func (mi *memIterator) assertValid() {
 
 func (mi *memIterator) Next() {
 	mi.assertValid()
+	current := mi.stack[len(mi.stack)-1]
+	mi.stack = mi.stack[:len(mi.stack)-1]
 	if mi.ascending {
-		mi.items = mi.items[1:]
+		mi.push(current.right)
 	} else {
-		mi.items = mi.items[:len(mi.items)-1]
+		mi.push(current.left)
 	}
+	mi.updateValid()
 }
 
 func (mi *memIterator) Key() []byte {
 	mi.assertValid()
-	if mi.ascending {
-		return mi.items[0].Key
-	}
-	return mi.items[len(mi.items)-1].Key
+	return mi.stack[len(mi.stack)-1].key
 }
 
 func (mi *memIterator) Value() []byte {
 	mi.assertValid()
-	if mi.ascending {
-		return mi.items[0].Value
-	}
-	return mi.items[len(mi.items)-1].Value
+	return mi.stack[len(mi.stack)-1].value
 }
 
 func (mi *memIterator) Close() {
 	mi.start = nil
 	mi.end = nil
-	mi.items = nil
+	mi.stack = nil
+	mi.valid = false
 }
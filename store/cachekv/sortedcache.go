@@ -0,0 +1,58 @@
+package cachekv
+
+import (
+	"bytes"
+	"math/rand"
+)
+
+// kvNode is a node of a persistent (copy-on-write) treap of key/value pairs
+// ordered by key. A write copies the O(log n) nodes on the path to its key and
+// never mutates a reachable node, so an iterator holding on to a root sees the
+// tree as it was when the iterator was created.
+//
+// A nil value means the key was deleted.
+type kvNode struct {
+	key      []byte
+	value    []byte
+	priority uint32
+	left     *kvNode
+	right    *kvNode
+}
+
+// set returns the root of a tree equal to n with key set to value.
+func (n *kvNode) set(key, value []byte) *kvNode {
+	if n == nil {
+		return &kvNode{key: key, value: value, priority: rand.Uint32()}
+	}
+	c := *n
+	switch bytes.Compare(key, n.key) {
+	case 0:
+		c.value = value
+	case -1:
+		c.left = c.left.set(key, value)
+		if c.left.priority > c.priority {
+			return c.rotateRight()
+		}
+	case 1:
+		c.right = c.right.set(key, value)
+		if c.right.priority > c.priority {
+			return c.rotateLeft()
+		}
+	}
+	return &c
+}
+
+// NOTE: rotations only mutate nodes freshly copied by set
+func (n *kvNode) rotateRight() *kvNode {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	return l
+}
+
+func (n *kvNode) rotateLeft() *kvNode {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	return r
+}
@@ -1,15 +1,10 @@
 package cachekv
 
 import (
-	"bytes"
-	"container/list"
 	"io"
 	"sort"
 	"sync"
 
-	cmn "github.com/tendermint/tendermint/libs/common"
-	dbm "github.com/tendermint/tm-db"
-
 	"github.com/pokt-network/posmint/store/types"
 
 	"github.com/pokt-network/posmint/store/tracekv"
@@ -25,11 +20,10 @@ type cValue struct {
 
 // Store wraps an in-memory cache around an underlying types.KVStore.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   *list.List // always ascending sorted
-	parent        types.KVStore
+	mtx         sync.Mutex
+	cache       map[string]*cValue
+	sortedCache *kvNode // dirty items, always ascending sorted
+	parent      types.KVStore
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
@@ -37,10 +31,8 @@ var _ types.CacheKVStore = (*Store)(nil)
 // nolint
 func NewStore(parent types.KVStore) *Store {
 	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   list.New(),
-		parent:        parent,
+		cache:  make(map[string]*cValue),
+		parent: parent,
 	}
 }
 
@@ -122,8 +114,7 @@ func (store *Store) Write() {
 
 	// Clear the cache
 	store.cache = make(map[string]*cValue)
-	store.unsortedCache = make(map[string]struct{})
-	store.sortedCache = list.New()
+	store.sortedCache = nil
 }
 
 //----------------------------------------
@@ -164,51 +155,11 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	store.dirtyItems(start, end)
 	cache = newMemIterator(start, end, store.sortedCache, ascending)
 
 	return newCacheMergeIterator(parent, cache, ascending)
 }
 
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	unsorted := make([]*cmn.KVPair, 0)
-
-	for key := range store.unsortedCache {
-		cacheValue := store.cache[key]
-		if dbm.IsKeyInDomain([]byte(key), start, end) {
-			unsorted = append(unsorted, &cmn.KVPair{Key: []byte(key), Value: cacheValue.value})
-			delete(store.unsortedCache, key)
-		}
-	}
-
-	sort.Slice(unsorted, func(i, j int) bool {
-		return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-	})
-
-	for e := store.sortedCache.Front(); e != nil && len(unsorted) != 0; {
-		uitem := unsorted[0]
-		sitem := e.Value.(*cmn.KVPair)
-		comp := bytes.Compare(uitem.Key, sitem.Key)
-		switch comp {
-		case -1:
-			unsorted = unsorted[1:]
-			store.sortedCache.InsertBefore(uitem, e)
-		case 1:
-			e = e.Next()
-		case 0:
-			unsorted = unsorted[1:]
-			e.Value = uitem
-			e = e.Next()
-		}
-	}
-
-	for _, kvp := range unsorted {
-		store.sortedCache.PushBack(kvp)
-	}
-
-}
-
 //----------------------------------------
 // etc
 
@@ -220,6 +171,6 @@ func (store *Store) setCacheValue(key, value []byte, deleted bool, dirty bool) {
 		dirty:   dirty,
 	}
 	if dirty {
-		store.unsortedCache[string(key)] = struct{}{}
+		store.sortedCache = store.sortedCache.set([]byte(string(key)), value)
 	}
 }
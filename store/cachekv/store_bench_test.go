@@ -44,3 +44,47 @@ func BenchmarkCacheKVStoreIterator1000(b *testing.B)   { benchmarkCacheKVStoreIt
 func BenchmarkCacheKVStoreIterator10000(b *testing.B)  { benchmarkCacheKVStoreIterator(10000, b) }
 func BenchmarkCacheKVStoreIterator50000(b *testing.B)  { benchmarkCacheKVStoreIterator(50000, b) }
 func BenchmarkCacheKVStoreIterator100000(b *testing.B) { benchmarkCacheKVStoreIterator(100000, b) }
+
+// creates an iterator over a narrow domain after every write, as keepers do
+// when iterating a prefix inside a block
+func benchmarkCacheKVStoreIteratorAfterWrite(numKVs, depth int, b *testing.B) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	cstore := cachekv.NewStore(mem)
+	for i := 1; i < depth; i++ {
+		cstore = cachekv.NewStore(cstore)
+	}
+	for i := 0; i < numKVs; i++ {
+		key := make([]byte, 32)
+		value := make([]byte, 32)
+
+		_, _ = rand.Read(key)
+		_, _ = rand.Read(value)
+
+		cstore.Set(key, value)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		cstore.Set(key, key)
+
+		iter := cstore.Iterator(key, append(key, 0))
+		for ; iter.Valid(); iter.Next() {
+		}
+		iter.Close()
+	}
+}
+
+func BenchmarkCacheKVStoreIteratorAfterWrite1000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorAfterWrite(1000, 1, b)
+}
+func BenchmarkCacheKVStoreIteratorAfterWrite10000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorAfterWrite(10000, 1, b)
+}
+func BenchmarkCacheKVStoreIteratorAfterWrite100000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorAfterWrite(100000, 1, b)
+}
+func BenchmarkCacheKVStoreNestedIteratorAfterWrite10000(b *testing.B) {
+	benchmarkCacheKVStoreIteratorAfterWrite(10000, 3, b)
+}
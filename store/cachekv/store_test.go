@@ -317,6 +317,44 @@ const (
 	totalOps = 5 // number of possible operations
 )
 
+func TestCacheKVIteratorIsolatedFromWrites(t *testing.T) {
+	st := newCacheKVStore()
+	for i := 0; i < 10; i += 2 {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+
+	itr := st.Iterator(nil, nil)
+	reverse := st.ReverseIterator(keyFmt(2), keyFmt(8))
+	// writes after creating the iterators are not seen by them
+	st.Set(keyFmt(3), valFmt(3))
+	st.Set(keyFmt(4), valFmt(40))
+	st.Delete(keyFmt(6))
+
+	var keys, values [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+		values = append(values, itr.Value())
+	}
+	itr.Close()
+	require.Equal(t, [][]byte{keyFmt(0), keyFmt(2), keyFmt(4), keyFmt(6), keyFmt(8)}, keys)
+	require.Equal(t, valFmt(4), values[2])
+
+	keys = nil
+	for ; reverse.Valid(); reverse.Next() {
+		keys = append(keys, reverse.Key())
+	}
+	reverse.Close()
+	require.Equal(t, [][]byte{keyFmt(6), keyFmt(4), keyFmt(2)}, keys)
+
+	// a new iterator sees them
+	keys = nil
+	for itr = st.Iterator(keyFmt(1), nil); itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+	require.Equal(t, [][]byte{keyFmt(2), keyFmt(3), keyFmt(4), keyFmt(8)}, keys)
+}
+
 func randInt(n int) int {
 	return cmn.RandInt() % n
 }
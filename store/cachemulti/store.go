@@ -7,6 +7,7 @@ import (
 
 	"github.com/pokt-network/posmint/store/cachekv"
 	"github.com/pokt-network/posmint/store/dbadapter"
+	"github.com/pokt-network/posmint/store/listenkv"
 	"github.com/pokt-network/posmint/store/types"
 )
 
@@ -24,6 +25,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -63,7 +66,12 @@ func NewStore(
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		// the writes of the nested cache are notified when it is written
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
 }
@@ -96,6 +104,29 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// SetListeners sets the listeners notified of the writes made to the cached
+// stores, including the writes flushed to them by nested caches. The writes
+// of the Store itself to its parents are not notified.
+func (cms Store) SetListeners(listeners map[types.StoreKey][]types.WriteListener) types.CacheMultiStore {
+	cms.listeners = listeners
+	return cms
+}
+
+// AddListenerToAll returns a copy of the Store whose writes to any of the cached
+// stores are also notified to listener, see SetListeners.
+func (cms Store) AddListenerToAll(listener types.WriteListener) types.CacheMultiStore {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(cms.stores))
+	for key := range cms.stores {
+		listeners[key] = append(append([]types.WriteListener{}, cms.listeners[key]...), listener)
+	}
+	return cms.SetListeners(listeners)
+}
+
+// ListeningEnabled returns if writes to the store of key are notified to listeners.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) > 0
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -124,6 +155,16 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithWrapper is like CacheMultiStore, but the cached stores are
+// first wrapped by wrap, e.g. to record the accesses made to them.
+func (cms Store) CacheMultiStoreWithWrapper(wrap func(types.StoreKey, types.KVStore) types.KVStore) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	for k := range cms.stores {
+		stores[k] = wrap(k, cms.GetKVStore(k))
+	}
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -135,10 +176,17 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
+	if cms.ListeningEnabled(key) {
+		return cms.GetKVStore(key)
+	}
 	return cms.stores[key].(types.Store)
 }
 
 // GetKVStore returns an underlying KVStore by key.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
-	return cms.stores[key].(types.KVStore)
+	store := cms.stores[key].(types.KVStore)
+	if cms.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, cms.listeners[key])
+	}
+	return store
 }
@@ -0,0 +1,294 @@
+package collections
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// KeyCodec encodes the keys of a collection. The byte order of encoded keys
+// must match the natural order of the keys, so that iteration is ordered.
+//
+// A collection panics if given a key of the wrong type, the same way keepers
+// panic on values that fail to marshal.
+type KeyCodec interface {
+	// Encode returns the encoding of key when it is the last part of a store key.
+	Encode(key interface{}) []byte
+	// Decode decodes a key encoded with Encode, which spans the whole of bz.
+	Decode(bz []byte) (interface{}, error)
+	// EncodeNonTerminal returns the encoding of key when other bytes follow it
+	// (e.g. the first part of a Pair), so that its end can be found.
+	EncodeNonTerminal(key interface{}) []byte
+	// DecodeNonTerminal decodes a key encoded with EncodeNonTerminal from the
+	// start of bz and returns the number of bytes read.
+	DecodeNonTerminal(bz []byte) (int, interface{}, error)
+	// Stringify returns a human readable representation of the key.
+	Stringify(key interface{}) string
+}
+
+// ValueCodec encodes the values of a collection.
+type ValueCodec interface {
+	Encode(value interface{}) []byte
+	Decode(bz []byte) (interface{}, error)
+	Stringify(value interface{}) string
+}
+
+func typeError(codec string, expected string, got interface{}) string {
+	return fmt.Sprintf("%s expects a %s, got %T", codec, expected, got)
+}
+
+// fixed size keys encode the same way terminal or not
+type fixedSizeKey struct {
+	size int
+}
+
+//----------------------------------------
+// Key codecs
+
+var (
+	// Uint64Key encodes uint64 keys in big endian
+	Uint64Key KeyCodec = uint64Key{fixedSizeKey{8}}
+	// Int64Key encodes int64 keys in big endian with the sign bit flipped, so negative keys sort first
+	Int64Key KeyCodec = int64Key{fixedSizeKey{8}}
+	// StringKey encodes string keys
+	StringKey KeyCodec = stringKey{}
+	// BytesKey encodes []byte keys
+	BytesKey KeyCodec = bytesKey{}
+	// TimeKey encodes time.Time keys in the sortable time format
+	TimeKey KeyCodec = timeKey{fixedSizeKey{len(sdk.FormatTimeBytes(time.Time{}))}}
+)
+
+type uint64Key struct{ fixedSizeKey }
+
+func (uint64Key) Encode(key interface{}) []byte {
+	k, ok := key.(uint64)
+	if !ok {
+		panic(typeError("Uint64Key", "uint64", key))
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, k)
+	return bz
+}
+
+func (c uint64Key) Decode(bz []byte) (interface{}, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("Uint64Key expects 8 bytes, got %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (c uint64Key) EncodeNonTerminal(key interface{}) []byte { return c.Encode(key) }
+
+func (c uint64Key) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	return c.decodeFixed(c, bz)
+}
+
+func (uint64Key) Stringify(key interface{}) string { return fmt.Sprintf("%d", key) }
+
+type int64Key struct{ fixedSizeKey }
+
+func (int64Key) Encode(key interface{}) []byte {
+	k, ok := key.(int64)
+	if !ok {
+		panic(typeError("Int64Key", "int64", key))
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(k)^(1<<63))
+	return bz
+}
+
+func (int64Key) Decode(bz []byte) (interface{}, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("Int64Key expects 8 bytes, got %d", len(bz))
+	}
+	return int64(binary.BigEndian.Uint64(bz) ^ (1 << 63)), nil
+}
+
+func (c int64Key) EncodeNonTerminal(key interface{}) []byte { return c.Encode(key) }
+
+func (c int64Key) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	return c.decodeFixed(c, bz)
+}
+
+func (int64Key) Stringify(key interface{}) string { return fmt.Sprintf("%d", key) }
+
+type timeKey struct{ fixedSizeKey }
+
+func (timeKey) Encode(key interface{}) []byte {
+	k, ok := key.(time.Time)
+	if !ok {
+		panic(typeError("TimeKey", "time.Time", key))
+	}
+	return sdk.FormatTimeBytes(k)
+}
+
+func (timeKey) Decode(bz []byte) (interface{}, error) {
+	return sdk.ParseTimeBytes(bz)
+}
+
+func (c timeKey) EncodeNonTerminal(key interface{}) []byte { return c.Encode(key) }
+
+func (c timeKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	return c.decodeFixed(c, bz)
+}
+
+func (timeKey) Stringify(key interface{}) string { return fmt.Sprintf("%s", key) }
+
+func (f fixedSizeKey) decodeFixed(c KeyCodec, bz []byte) (int, interface{}, error) {
+	if len(bz) < f.size {
+		return 0, nil, fmt.Errorf("expected at least %d bytes, got %d", f.size, len(bz))
+	}
+	key, err := c.Decode(bz[:f.size])
+	return f.size, key, err
+}
+
+// Variable size keys are escaped when not terminal so their end can be found
+// while preserving their order: 0x00 bytes are written as 0x00 0xFF and the
+// key is terminated by 0x00 0x01, which sorts before any escaped byte.
+func encodeEscaped(bz []byte) []byte {
+	escaped := make([]byte, 0, len(bz)+2)
+	for _, b := range bz {
+		if b == 0x00 {
+			escaped = append(escaped, 0x00, 0xFF)
+		} else {
+			escaped = append(escaped, b)
+		}
+	}
+	return append(escaped, 0x00, 0x01)
+}
+
+func decodeEscaped(bz []byte) (int, []byte, error) {
+	key := []byte{}
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+		if i+1 == len(bz) {
+			break
+		}
+		switch bz[i+1] {
+		case 0x01:
+			return i + 2, key, nil
+		case 0xFF:
+			key = append(key, 0x00)
+			i++
+		default:
+			return 0, nil, fmt.Errorf("invalid escape sequence 0x00%02X", bz[i+1])
+		}
+	}
+	return 0, nil, fmt.Errorf("missing key terminator")
+}
+
+type stringKey struct{}
+
+func (stringKey) Encode(key interface{}) []byte {
+	k, ok := key.(string)
+	if !ok {
+		panic(typeError("StringKey", "string", key))
+	}
+	return []byte(k)
+}
+
+func (stringKey) Decode(bz []byte) (interface{}, error) { return string(bz), nil }
+
+func (c stringKey) EncodeNonTerminal(key interface{}) []byte {
+	return encodeEscaped(c.Encode(key))
+}
+
+func (stringKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	n, k, err := decodeEscaped(bz)
+	return n, string(k), err
+}
+
+func (stringKey) Stringify(key interface{}) string { return fmt.Sprintf("%s", key) }
+
+type bytesKey struct{}
+
+func (bytesKey) Encode(key interface{}) []byte {
+	k, ok := key.([]byte)
+	if !ok {
+		panic(typeError("BytesKey", "[]byte", key))
+	}
+	return append([]byte{}, k...)
+}
+
+func (bytesKey) Decode(bz []byte) (interface{}, error) { return append([]byte{}, bz...), nil }
+
+func (c bytesKey) EncodeNonTerminal(key interface{}) []byte {
+	return encodeEscaped(c.Encode(key))
+}
+
+func (bytesKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	return decodeEscaped(bz)
+}
+
+func (bytesKey) Stringify(key interface{}) string { return fmt.Sprintf("%X", key) }
+
+//----------------------------------------
+// Value codecs
+
+var (
+	// Uint64Value encodes uint64 values in big endian
+	Uint64Value ValueCodec = uint64Value{}
+	// StringValue encodes string values
+	StringValue ValueCodec = stringValue{}
+	// BytesValue encodes []byte values
+	BytesValue ValueCodec = bytesValue{}
+)
+
+type uint64Value struct{}
+
+func (uint64Value) Encode(value interface{}) []byte { return Uint64Key.Encode(value) }
+
+func (uint64Value) Decode(bz []byte) (interface{}, error) { return Uint64Key.Decode(bz) }
+
+func (uint64Value) Stringify(value interface{}) string { return fmt.Sprintf("%d", value) }
+
+type stringValue struct{}
+
+func (stringValue) Encode(value interface{}) []byte { return StringKey.Encode(value) }
+
+func (stringValue) Decode(bz []byte) (interface{}, error) { return string(bz), nil }
+
+func (stringValue) Stringify(value interface{}) string { return fmt.Sprintf("%s", value) }
+
+type bytesValue struct{}
+
+func (bytesValue) Encode(value interface{}) []byte { return BytesKey.Encode(value) }
+
+func (bytesValue) Decode(bz []byte) (interface{}, error) { return append([]byte{}, bz...), nil }
+
+func (bytesValue) Stringify(value interface{}) string { return fmt.Sprintf("%X", value) }
+
+type aminoValue struct {
+	cdc *codec.Codec
+	typ reflect.Type
+}
+
+// AminoValue returns a value codec that amino encodes values of the type of
+// prototype (e.g. AminoValue(cdc, types.Validator{})).
+func AminoValue(cdc *codec.Codec, prototype interface{}) ValueCodec {
+	return aminoValue{cdc: cdc, typ: reflect.TypeOf(prototype)}
+}
+
+func (c aminoValue) Encode(value interface{}) []byte {
+	if reflect.TypeOf(value) != c.typ {
+		panic(typeError("AminoValue", c.typ.String(), value))
+	}
+	return c.cdc.MustMarshalBinaryLengthPrefixed(value)
+}
+
+func (c aminoValue) Decode(bz []byte) (interface{}, error) {
+	ptr := reflect.New(c.typ)
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(bz, ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Elem().Interface(), nil
+}
+
+func (c aminoValue) Stringify(value interface{}) string { return fmt.Sprintf("%v", value) }
@@ -0,0 +1,206 @@
+package collections
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+func defaultContext(key sdk.StoreKey) sdk.Context {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	cms.LoadLatestVersion()
+	return sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+}
+
+func TestKeyCodecsPreserveOrder(t *testing.T) {
+	cases := []struct {
+		codec KeyCodec
+		keys  []interface{}
+	}{
+		{Uint64Key, []interface{}{uint64(0), uint64(1), uint64(256), uint64(1 << 40)}},
+		{Int64Key, []interface{}{int64(-1 << 40), int64(-1), int64(0), int64(1), int64(1 << 40)}},
+		{StringKey, []interface{}{"", "a", "ab", "b"}},
+		{BytesKey, []interface{}{[]byte{}, []byte{0}, []byte{0, 1}, []byte{1}}},
+		{TimeKey, []interface{}{time.Unix(0, 0).UTC(), time.Unix(1, 0).UTC(), time.Unix(1, 1).UTC()}},
+		{PairKeyCodec(StringKey, Uint64Key), []interface{}{Join("a", uint64(2)), Join("ab", uint64(1)), Join("b", uint64(0))}},
+	}
+	for _, tc := range cases {
+		for i, key := range tc.keys {
+			bz := tc.codec.Encode(key)
+			decoded, err := tc.codec.Decode(bz)
+			require.NoError(t, err)
+			require.Equal(t, key, decoded)
+
+			n, decoded, err := tc.codec.DecodeNonTerminal(append(tc.codec.EncodeNonTerminal(key), 0xFF))
+			require.NoError(t, err)
+			require.Equal(t, key, decoded)
+			require.Equal(t, len(tc.codec.EncodeNonTerminal(key)), n)
+
+			if i > 0 {
+				require.True(t, string(tc.codec.Encode(tc.keys[i-1])) < string(bz), tc.codec.Stringify(key))
+			}
+		}
+	}
+	require.Panics(t, func() { Uint64Key.Encode(1) })
+}
+
+func TestSchemaOverlappingPrefixes(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	sb := NewSchemaBuilder(key)
+	NewItem(sb, []byte{0x01}, "item", StringValue)
+	NewMap(sb, []byte{0x02}, "map", StringKey, StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	require.Equal(t, []string{"item", "map"}, schema.Names())
+
+	sb = NewSchemaBuilder(key)
+	NewItem(sb, []byte{0x01}, "item", StringValue)
+	NewMap(sb, []byte{0x01, 0x02}, "map", StringKey, StringValue)
+	_, err = sb.Build()
+	require.Error(t, err)
+
+	sb = NewSchemaBuilder(key)
+	NewItem(sb, []byte{0x01}, "item", StringValue)
+	NewItem(sb, []byte{0x02}, "item", StringValue)
+	_, err = sb.Build()
+	require.Error(t, err)
+}
+
+func TestItemAndSequence(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := defaultContext(key)
+	sb := NewSchemaBuilder(key)
+	item := NewItem(sb, []byte{0x01}, "item", StringValue)
+	seq := NewSequence(sb, []byte{0x02}, "seq")
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	_, found := item.Get(ctx)
+	require.False(t, found)
+	item.Set(ctx, "value")
+	value, found := item.Get(ctx)
+	require.True(t, found)
+	require.Equal(t, "value", value)
+	item.Remove(ctx)
+	require.False(t, item.Has(ctx))
+
+	require.Equal(t, uint64(0), seq.Peek(ctx))
+	require.Equal(t, uint64(0), seq.Next(ctx))
+	require.Equal(t, uint64(1), seq.Next(ctx))
+	require.Equal(t, uint64(2), seq.Peek(ctx))
+	seq.Set(ctx, 10)
+	require.Equal(t, uint64(10), seq.Next(ctx))
+}
+
+type account struct {
+	Owner   string
+	Balance int64
+}
+
+func TestMapIteration(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := defaultContext(key)
+	cdc := codec.New()
+	sb := NewSchemaBuilder(key)
+	accounts := NewMap(sb, []byte{0x01}, "accounts", PairKeyCodec(StringKey, Uint64Key), AminoValue(cdc, account{}))
+	// a neighbouring collection must never show up in the iteration
+	other := NewKeySet(sb, []byte{0x02}, "other", StringKey)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	other.Set(ctx, "x")
+	for _, owner := range []string{"bob", "alice", "bo"} {
+		for _, id := range []uint64{3, 1, 2} {
+			accounts.Set(ctx, Join(owner, id), account{Owner: owner, Balance: int64(id)})
+		}
+	}
+	value, found := accounts.Get(ctx, Join("bob", uint64(2)))
+	require.True(t, found)
+	require.Equal(t, account{Owner: "bob", Balance: 2}, value)
+	require.Panics(t, func() { accounts.Set(ctx, Join("bob", uint64(4)), &account{}) })
+
+	keys := accounts.Keys(ctx, nil)
+	require.Len(t, keys, 9)
+	require.Equal(t, Join("alice", uint64(1)), keys[0])
+	require.Equal(t, Join("bo", uint64(1)), keys[3])
+	require.Equal(t, Join("bob", uint64(3)), keys[8])
+
+	// the prefix of "bo" doesn't match the keys of "bob"
+	require.Equal(t, []interface{}{Join("bo", uint64(1)), Join("bo", uint64(2)), Join("bo", uint64(3))},
+		accounts.Keys(ctx, &Range{Prefix: PairPrefix("bo")}))
+	require.Equal(t, []interface{}{Join("bob", uint64(3)), Join("bob", uint64(2)), Join("bob", uint64(1))},
+		accounts.Keys(ctx, &Range{Prefix: PairPrefix("bob"), Descending: true}))
+	require.Equal(t, []interface{}{Join("alice", uint64(3)), Join("bo", uint64(1))},
+		accounts.Keys(ctx, &Range{Start: Join("alice", uint64(3)), End: Join("bo", uint64(2))}))
+
+	var total int64
+	accounts.Iterate(ctx, nil, func(_, value interface{}) bool {
+		total += value.(account).Balance
+		return total >= 6
+	})
+	require.Equal(t, int64(6), total)
+
+	accounts.Remove(ctx, Join("bob", uint64(2)))
+	require.False(t, accounts.Has(ctx, Join("bob", uint64(2))))
+	require.Len(t, accounts.Keys(ctx, nil), 8)
+}
+
+func TestIndexedMap(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := defaultContext(key)
+	cdc := codec.New()
+	sb := NewSchemaBuilder(key)
+	byOwner := NewMultiIndex(sb, []byte{0x02}, "accounts_by_owner", StringKey, Uint64Key,
+		func(_, value interface{}) interface{} { return value.(account).Owner })
+	byBalance := NewUniqueIndex(sb, []byte{0x03}, "accounts_by_balance", Int64Key, Uint64Key,
+		func(_, value interface{}) interface{} { return value.(account).Balance })
+	accounts := NewIndexedMap(sb, []byte{0x01}, "accounts", Uint64Key, AminoValue(cdc, account{}), byOwner, byBalance)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, accounts.Set(ctx, uint64(1), account{Owner: "alice", Balance: 10}))
+	require.NoError(t, accounts.Set(ctx, uint64(2), account{Owner: "bob", Balance: 20}))
+	require.NoError(t, accounts.Set(ctx, uint64(3), account{Owner: "alice", Balance: 30}))
+
+	owned := func(owner string) (pks []interface{}) {
+		byOwner.Iterate(ctx, owner, func(pk interface{}) bool {
+			pks = append(pks, pk)
+			return false
+		})
+		return pks
+	}
+	require.Equal(t, []interface{}{uint64(1), uint64(3)}, owned("alice"))
+	pk, found := byBalance.Get(ctx, int64(20))
+	require.True(t, found)
+	require.Equal(t, uint64(2), pk)
+
+	// a conflicting unique reference fails without writing anything
+	require.Error(t, accounts.Set(ctx, uint64(4), account{Owner: "carol", Balance: 10}))
+	require.False(t, accounts.Has(ctx, uint64(4)))
+	require.Empty(t, owned("carol"))
+
+	// updating a value moves its index entries
+	require.NoError(t, accounts.Set(ctx, uint64(3), account{Owner: "bob", Balance: 31}))
+	require.Equal(t, []interface{}{uint64(1)}, owned("alice"))
+	require.Equal(t, []interface{}{uint64(2), uint64(3)}, owned("bob"))
+	_, found = byBalance.Get(ctx, int64(30))
+	require.False(t, found)
+	require.True(t, byOwner.Has(ctx, "bob", uint64(3)))
+
+	accounts.Remove(ctx, uint64(2))
+	require.Equal(t, []interface{}{uint64(3)}, owned("bob"))
+	_, found = byBalance.Get(ctx, int64(20))
+	require.False(t, found)
+	// the balance is free again
+	require.NoError(t, accounts.Set(ctx, uint64(4), account{Owner: "carol", Balance: 20}))
+}
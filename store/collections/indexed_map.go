@@ -0,0 +1,153 @@
+package collections
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Index is a secondary index of an IndexedMap, kept up to date by the map.
+type Index interface {
+	// checks that the value can be indexed under pk, before anything is written
+	check(ctx sdk.Context, pk, value interface{}) error
+	// indexes the value under pk
+	reference(ctx sdk.Context, pk, value interface{})
+	// removes the value under pk from the index
+	unreference(ctx sdk.Context, pk, value interface{})
+}
+
+// IndexedMap is a Map with secondary indexes.
+type IndexedMap struct {
+	Map
+	indexes []Index
+}
+
+// NewIndexedMap registers an IndexedMap stored under prefix. The indexes are registered
+// separately, under their own prefixes.
+func NewIndexedMap(sb *SchemaBuilder, prefix []byte, name string, keyCodec KeyCodec, valueCodec ValueCodec, indexes ...Index) IndexedMap {
+	return IndexedMap{Map: NewMap(sb, prefix, name, keyCodec, valueCodec), indexes: indexes}
+}
+
+// Set sets the value of pk and updates the indexes. It fails, without writing
+// anything, if the value conflicts with an unique index.
+func (m IndexedMap) Set(ctx sdk.Context, pk, value interface{}) error {
+	for _, index := range m.indexes {
+		if err := index.check(ctx, pk, value); err != nil {
+			return err
+		}
+	}
+	if old, found := m.Map.Get(ctx, pk); found {
+		for _, index := range m.indexes {
+			index.unreference(ctx, pk, old)
+		}
+	}
+	for _, index := range m.indexes {
+		index.reference(ctx, pk, value)
+	}
+	m.Map.Set(ctx, pk, value)
+	return nil
+}
+
+// Remove deletes pk and its index entries.
+func (m IndexedMap) Remove(ctx sdk.Context, pk interface{}) {
+	old, found := m.Map.Get(ctx, pk)
+	if !found {
+		return
+	}
+	for _, index := range m.indexes {
+		index.unreference(ctx, pk, old)
+	}
+	m.Map.Remove(ctx, pk)
+}
+
+// MultiIndex indexes the primary keys of a map by a reference key many values can share
+// (e.g. validators by status). It is stored as a set of (reference key, primary key) pairs.
+type MultiIndex struct {
+	refs   KeySet
+	getRef func(pk, value interface{}) interface{}
+}
+
+// NewMultiIndex registers a MultiIndex stored under prefix. getRef returns the reference key of a value.
+func NewMultiIndex(sb *SchemaBuilder, prefix []byte, name string, refCodec, pkCodec KeyCodec,
+	getRef func(pk, value interface{}) interface{}) *MultiIndex {
+	return &MultiIndex{refs: NewKeySet(sb, prefix, name, PairKeyCodec(refCodec, pkCodec)), getRef: getRef}
+}
+
+func (i *MultiIndex) check(sdk.Context, interface{}, interface{}) error { return nil }
+
+func (i *MultiIndex) reference(ctx sdk.Context, pk, value interface{}) {
+	i.refs.Set(ctx, Join(i.getRef(pk, value), pk))
+}
+
+func (i *MultiIndex) unreference(ctx sdk.Context, pk, value interface{}) {
+	i.refs.Remove(ctx, Join(i.getRef(pk, value), pk))
+}
+
+// Has returns true if the value of pk is indexed under ref.
+func (i *MultiIndex) Has(ctx sdk.Context, ref, pk interface{}) bool {
+	return i.refs.Has(ctx, Join(ref, pk))
+}
+
+// Iterate calls fn on the primary keys indexed under ref, in primary key order, until fn returns true.
+func (i *MultiIndex) Iterate(ctx sdk.Context, ref interface{}, fn func(pk interface{}) (stop bool)) {
+	i.refs.Iterate(ctx, &Range{Prefix: PairPrefix(ref)}, func(key interface{}) bool {
+		return fn(key.(Pair).K2)
+	})
+}
+
+// UniqueIndex indexes the primary keys of a map by a reference key no two values can share
+// (e.g. validators by consensus address).
+type UniqueIndex struct {
+	refs   Map
+	getRef func(pk, value interface{}) interface{}
+}
+
+// NewUniqueIndex registers a UniqueIndex stored under prefix. getRef returns the reference key of a value.
+func NewUniqueIndex(sb *SchemaBuilder, prefix []byte, name string, refCodec, pkCodec KeyCodec,
+	getRef func(pk, value interface{}) interface{}) *UniqueIndex {
+	return &UniqueIndex{refs: NewMap(sb, prefix, name, refCodec, keyValue{pkCodec}), getRef: getRef}
+}
+
+func (i *UniqueIndex) check(ctx sdk.Context, pk, value interface{}) error {
+	ref := i.getRef(pk, value)
+	other, found := i.refs.Get(ctx, ref)
+	if found && !equalKeys(i.refs.valueCodec, other, pk) {
+		return fmt.Errorf("%s %s is already used by %s", i.refs.name,
+			i.refs.keyCodec.Stringify(ref), i.refs.valueCodec.Stringify(other))
+	}
+	return nil
+}
+
+func (i *UniqueIndex) reference(ctx sdk.Context, pk, value interface{}) {
+	i.refs.Set(ctx, i.getRef(pk, value), pk)
+}
+
+func (i *UniqueIndex) unreference(ctx sdk.Context, pk, value interface{}) {
+	i.refs.Remove(ctx, i.getRef(pk, value))
+}
+
+// Get returns the primary key indexed under ref.
+func (i *UniqueIndex) Get(ctx sdk.Context, ref interface{}) (pk interface{}, found bool) {
+	return i.refs.Get(ctx, ref)
+}
+
+// Iterate calls fn on the reference/primary key pairs in rng, in reference key order, until fn returns true.
+func (i *UniqueIndex) Iterate(ctx sdk.Context, rng *Range, fn func(ref, pk interface{}) (stop bool)) {
+	i.refs.Iterate(ctx, rng, fn)
+}
+
+// keyValue stores primary keys as the values of an index
+type keyValue struct {
+	keyCodec KeyCodec
+}
+
+func (c keyValue) Encode(value interface{}) []byte { return c.keyCodec.Encode(value) }
+
+func (c keyValue) Decode(bz []byte) (interface{}, error) { return c.keyCodec.Decode(bz) }
+
+func (c keyValue) Stringify(value interface{}) string { return c.keyCodec.Stringify(value) }
+
+// keys of any type are equal if their encodings are
+func equalKeys(codec ValueCodec, a, b interface{}) bool {
+	return string(codec.Encode(a)) == string(codec.Encode(b))
+}
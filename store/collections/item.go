@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Item is a single value stored under its prefix (e.g. the params of a module).
+type Item struct {
+	collection
+	valueCodec ValueCodec
+}
+
+// NewItem registers an Item stored under prefix.
+func NewItem(sb *SchemaBuilder, prefix []byte, name string, valueCodec ValueCodec) Item {
+	return Item{collection: newCollection(sb, prefix, name), valueCodec: valueCodec}
+}
+
+// Get returns the value of the item and whether it is set.
+func (i Item) Get(ctx sdk.Context) (value interface{}, found bool) {
+	bz := i.store(ctx).Get(i.prefix)
+	if bz == nil {
+		return nil, false
+	}
+	return decodeValue(i.name, i.valueCodec, bz), true
+}
+
+// Has returns true if the item is set.
+func (i Item) Has(ctx sdk.Context) bool {
+	return i.store(ctx).Has(i.prefix)
+}
+
+// Set sets the value of the item.
+func (i Item) Set(ctx sdk.Context, value interface{}) {
+	i.store(ctx).Set(i.prefix, i.valueCodec.Encode(value))
+}
+
+// Remove unsets the item.
+func (i Item) Remove(ctx sdk.Context) {
+	i.store(ctx).Delete(i.prefix)
+}
+
+// Sequence is a monotonically increasing uint64 counter, e.g. to allocate ids.
+type Sequence struct {
+	item Item
+}
+
+// NewSequence registers a Sequence stored under prefix. It starts at 0.
+func NewSequence(sb *SchemaBuilder, prefix []byte, name string) Sequence {
+	return Sequence{item: NewItem(sb, prefix, name, Uint64Value)}
+}
+
+// Peek returns the next value of the sequence without incrementing it.
+func (s Sequence) Peek(ctx sdk.Context) uint64 {
+	value, found := s.item.Get(ctx)
+	if !found {
+		return 0
+	}
+	return value.(uint64)
+}
+
+// Next returns the next value of the sequence and increments it.
+func (s Sequence) Next(ctx sdk.Context) uint64 {
+	value := s.Peek(ctx)
+	s.item.Set(ctx, value+1)
+	return value
+}
+
+// Set sets the next value of the sequence, e.g. when importing genesis.
+func (s Sequence) Set(ctx sdk.Context, value uint64) {
+	s.item.Set(ctx, value)
+}
+
+// a value that fails to decode means the store is corrupted
+func decodeValue(name string, codec ValueCodec, bz []byte) interface{} {
+	value, err := codec.Decode(bz)
+	if err != nil {
+		panic(fmt.Sprintf("failed to decode value of collection %s: %v", name, err))
+	}
+	return value
+}
+
+func decodeKey(name string, codec KeyCodec, bz []byte) interface{} {
+	key, err := codec.Decode(bz)
+	if err != nil {
+		panic(fmt.Sprintf("failed to decode key of collection %s: %v", name, err))
+	}
+	return key
+}
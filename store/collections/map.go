@@ -0,0 +1,140 @@
+package collections
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Range restricts the keys an iteration goes over. Either Prefix or
+// Start/End can be set; a nil Range iterates over the whole collection.
+type Range struct {
+	// Prefix matches every key whose encoding starts with the encoding of
+	// Prefix, e.g. a PairPrefix to iterate over the keys sharing a first part
+	Prefix interface{}
+	// Start is the first key of the iteration (inclusive)
+	Start interface{}
+	// End is the key the iteration stops at (exclusive)
+	End interface{}
+	// Descending iterates from the greatest key down
+	Descending bool
+}
+
+// Map is a mapping of keys to values.
+type Map struct {
+	collection
+	keyCodec   KeyCodec
+	valueCodec ValueCodec
+}
+
+// NewMap registers a Map stored under prefix.
+func NewMap(sb *SchemaBuilder, prefix []byte, name string, keyCodec KeyCodec, valueCodec ValueCodec) Map {
+	return Map{collection: newCollection(sb, prefix, name), keyCodec: keyCodec, valueCodec: valueCodec}
+}
+
+// Get returns the value of key and whether it is set.
+func (m Map) Get(ctx sdk.Context, key interface{}) (value interface{}, found bool) {
+	bz := m.store(ctx).Get(m.storeKeyOf(m.keyCodec.Encode(key)))
+	if bz == nil {
+		return nil, false
+	}
+	return decodeValue(m.name, m.valueCodec, bz), true
+}
+
+// Has returns true if key is set.
+func (m Map) Has(ctx sdk.Context, key interface{}) bool {
+	return m.store(ctx).Has(m.storeKeyOf(m.keyCodec.Encode(key)))
+}
+
+// Set sets the value of key.
+func (m Map) Set(ctx sdk.Context, key, value interface{}) {
+	m.store(ctx).Set(m.storeKeyOf(m.keyCodec.Encode(key)), m.valueCodec.Encode(value))
+}
+
+// Remove deletes key.
+func (m Map) Remove(ctx sdk.Context, key interface{}) {
+	m.store(ctx).Delete(m.storeKeyOf(m.keyCodec.Encode(key)))
+}
+
+// Iterate calls fn on every key/value pair in rng, in key order, until fn returns true.
+// The map must not be written to during the iteration.
+func (m Map) Iterate(ctx sdk.Context, rng *Range, fn func(key, value interface{}) (stop bool)) {
+	iterate(ctx, m.collection, m.keyCodec, rng, func(key interface{}, bz []byte) bool {
+		return fn(key, decodeValue(m.name, m.valueCodec, bz))
+	})
+}
+
+// Keys returns the keys in rng, in key order.
+func (m Map) Keys(ctx sdk.Context, rng *Range) (keys []interface{}) {
+	iterate(ctx, m.collection, m.keyCodec, rng, func(key interface{}, _ []byte) bool {
+		keys = append(keys, key)
+		return false
+	})
+	return keys
+}
+
+// KeySet is a set of keys.
+type KeySet struct {
+	collection
+	keyCodec KeyCodec
+}
+
+// NewKeySet registers a KeySet stored under prefix.
+func NewKeySet(sb *SchemaBuilder, prefix []byte, name string, keyCodec KeyCodec) KeySet {
+	return KeySet{collection: newCollection(sb, prefix, name), keyCodec: keyCodec}
+}
+
+// Has returns true if key is in the set.
+func (s KeySet) Has(ctx sdk.Context, key interface{}) bool {
+	return s.store(ctx).Has(s.storeKeyOf(s.keyCodec.Encode(key)))
+}
+
+// Set adds key to the set.
+func (s KeySet) Set(ctx sdk.Context, key interface{}) {
+	s.store(ctx).Set(s.storeKeyOf(s.keyCodec.Encode(key)), []byte{})
+}
+
+// Remove removes key from the set.
+func (s KeySet) Remove(ctx sdk.Context, key interface{}) {
+	s.store(ctx).Delete(s.storeKeyOf(s.keyCodec.Encode(key)))
+}
+
+// Iterate calls fn on every key in rng, in key order, until fn returns true.
+// The set must not be written to during the iteration.
+func (s KeySet) Iterate(ctx sdk.Context, rng *Range, fn func(key interface{}) (stop bool)) {
+	iterate(ctx, s.collection, s.keyCodec, rng, func(key interface{}, _ []byte) bool {
+		return fn(key)
+	})
+}
+
+func iterate(ctx sdk.Context, c collection, keyCodec KeyCodec, rng *Range, fn func(key interface{}, value []byte) bool) {
+	start, end := c.prefix, sdk.PrefixEndBytes(c.prefix)
+	descending := false
+	if rng != nil {
+		if rng.Prefix != nil && (rng.Start != nil || rng.End != nil) {
+			panic("a collection range can't have both a prefix and bounds")
+		}
+		if rng.Prefix != nil {
+			start = c.storeKeyOf(keyCodec.Encode(rng.Prefix))
+			end = sdk.PrefixEndBytes(start)
+		}
+		if rng.Start != nil {
+			start = c.storeKeyOf(keyCodec.Encode(rng.Start))
+		}
+		if rng.End != nil {
+			end = c.storeKeyOf(keyCodec.Encode(rng.End))
+		}
+		descending = rng.Descending
+	}
+	var iter sdk.Iterator
+	if descending {
+		iter = c.store(ctx).ReverseIterator(start, end)
+	} else {
+		iter = c.store(ctx).Iterator(start, end)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := decodeKey(c.name, keyCodec, iter.Key()[len(c.prefix):])
+		if fn(key, iter.Value()) {
+			return
+		}
+	}
+}
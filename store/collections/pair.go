@@ -0,0 +1,88 @@
+package collections
+
+import "fmt"
+
+// Pair is a composite key of two parts, encoded by a PairKeyCodec. A Pair
+// with a nil K2 only matches the first part and can be used as a Range
+// prefix to iterate over every key starting with K1.
+type Pair struct {
+	K1 interface{}
+	K2 interface{}
+}
+
+// Join returns the pair key (k1, k2).
+func Join(k1, k2 interface{}) Pair {
+	return Pair{K1: k1, K2: k2}
+}
+
+// PairPrefix returns the prefix of every pair key starting with k1.
+func PairPrefix(k1 interface{}) Pair {
+	return Pair{K1: k1}
+}
+
+type pairKey struct {
+	k1 KeyCodec
+	k2 KeyCodec
+}
+
+// PairKeyCodec returns a codec of Pair keys ordered by their first then second part.
+func PairKeyCodec(k1, k2 KeyCodec) KeyCodec {
+	return pairKey{k1: k1, k2: k2}
+}
+
+func (c pairKey) pair(key interface{}) Pair {
+	p, ok := key.(Pair)
+	if !ok {
+		panic(typeError("PairKeyCodec", "Pair", key))
+	}
+	return p
+}
+
+func (c pairKey) Encode(key interface{}) []byte {
+	p := c.pair(key)
+	bz := c.k1.EncodeNonTerminal(p.K1)
+	if p.K2 == nil {
+		return bz
+	}
+	return append(bz, c.k2.Encode(p.K2)...)
+}
+
+func (c pairKey) Decode(bz []byte) (interface{}, error) {
+	n, k1, err := c.k1.DecodeNonTerminal(bz)
+	if err != nil {
+		return nil, err
+	}
+	k2, err := c.k2.Decode(bz[n:])
+	if err != nil {
+		return nil, err
+	}
+	return Pair{K1: k1, K2: k2}, nil
+}
+
+func (c pairKey) EncodeNonTerminal(key interface{}) []byte {
+	p := c.pair(key)
+	if p.K2 == nil {
+		panic("a pair prefix can't be part of another key")
+	}
+	return append(c.k1.EncodeNonTerminal(p.K1), c.k2.EncodeNonTerminal(p.K2)...)
+}
+
+func (c pairKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	n1, k1, err := c.k1.DecodeNonTerminal(bz)
+	if err != nil {
+		return 0, nil, err
+	}
+	n2, k2, err := c.k2.DecodeNonTerminal(bz[n1:])
+	if err != nil {
+		return 0, nil, err
+	}
+	return n1 + n2, Pair{K1: k1, K2: k2}, nil
+}
+
+func (c pairKey) Stringify(key interface{}) string {
+	p := c.pair(key)
+	if p.K2 == nil {
+		return fmt.Sprintf("(%s, *)", c.k1.Stringify(p.K1))
+	}
+	return fmt.Sprintf("(%s, %s)", c.k1.Stringify(p.K1), c.k2.Stringify(p.K2))
+}
@@ -0,0 +1,127 @@
+// Package collections provides typed views over the KVStore of a module, so
+// keepers don't have to hand roll key layouts and encodings.
+//
+// Every collection (Item, Sequence, Map, KeySet, IndexedMap and its indexes)
+// lives under its own prefix of a store and is registered with the
+// SchemaBuilder of that store, which rejects overlapping prefixes. Keys and
+// values are encoded by pluggable KeyCodec and ValueCodec implementations;
+// key codecs preserve ordering so iteration follows the order of the keys.
+//
+// The module targets go 1.13, which has no type parameters, so the collections
+// are not generic (there is no Map[K, V]): only their codecs are typed. Keys
+// and values are passed as interface{} and only checked at runtime by the
+// codecs, which panic on a value of the wrong type, the same way keepers panic
+// on values that fail to (un)marshal. Callers type assert the keys and values
+// they get back, e.g. value.(types.Validator) for an AminoValue of a Validator.
+package collections
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// SchemaBuilder registers the collections of a store.
+type SchemaBuilder struct {
+	storeKey    sdk.StoreKey
+	collections []collectionInfo
+	err         error
+}
+
+type collectionInfo struct {
+	name   string
+	prefix []byte
+}
+
+// NewSchemaBuilder returns a schema builder for the collections of the store of storeKey.
+func NewSchemaBuilder(storeKey sdk.StoreKey) *SchemaBuilder {
+	return &SchemaBuilder{storeKey: storeKey}
+}
+
+func (sb *SchemaBuilder) addCollection(name string, prefix []byte) {
+	if sb.err != nil {
+		return
+	}
+	switch {
+	case name == "":
+		sb.err = fmt.Errorf("collection name can't be empty")
+	case len(prefix) == 0:
+		sb.err = fmt.Errorf("prefix of collection %s can't be empty", name)
+	}
+	for _, c := range sb.collections {
+		if c.name == name {
+			sb.err = fmt.Errorf("collection %s registered twice", name)
+		}
+	}
+	sb.collections = append(sb.collections, collectionInfo{name: name, prefix: append([]byte{}, prefix...)})
+}
+
+// Build checks the registered collections and returns the schema of the store.
+// It fails if a prefix of a collection is a prefix of another one, as their keys could collide.
+func (sb *SchemaBuilder) Build() (Schema, error) {
+	if sb.err != nil {
+		return Schema{}, sb.err
+	}
+	collections := append([]collectionInfo{}, sb.collections...)
+	sort.Slice(collections, func(i, j int) bool { return bytes.Compare(collections[i].prefix, collections[j].prefix) < 0 })
+	// once sorted, a prefix of another collection's prefix sorts right before it
+	for i := 1; i < len(collections); i++ {
+		if bytes.HasPrefix(collections[i].prefix, collections[i-1].prefix) {
+			return Schema{}, fmt.Errorf("prefix %X of collection %s overlaps prefix %X of collection %s",
+				collections[i].prefix, collections[i].name, collections[i-1].prefix, collections[i-1].name)
+		}
+	}
+	return Schema{storeKey: sb.storeKey, collections: collections}, nil
+}
+
+// Schema describes the collections of a store.
+type Schema struct {
+	storeKey    sdk.StoreKey
+	collections []collectionInfo
+}
+
+// StoreKey returns the key of the store the collections live in.
+func (s Schema) StoreKey() sdk.StoreKey {
+	return s.storeKey
+}
+
+// Names returns the names of the collections, ordered by prefix.
+func (s Schema) Names() []string {
+	names := make([]string, len(s.collections))
+	for i, c := range s.collections {
+		names[i] = c.name
+	}
+	return names
+}
+
+// the fields shared by all the collections
+type collection struct {
+	name     string
+	prefix   []byte
+	storeKey sdk.StoreKey
+}
+
+func newCollection(sb *SchemaBuilder, prefix []byte, name string) collection {
+	sb.addCollection(name, prefix)
+	return collection{name: name, prefix: append([]byte{}, prefix...), storeKey: sb.storeKey}
+}
+
+// Name returns the name of the collection.
+func (c collection) Name() string {
+	return c.name
+}
+
+// Prefix returns the prefix the collection is stored under.
+func (c collection) Prefix() []byte {
+	return c.prefix
+}
+
+func (c collection) store(ctx sdk.Context) sdk.KVStore {
+	return ctx.KVStore(c.storeKey)
+}
+
+func (c collection) storeKeyOf(key []byte) []byte {
+	return append(append(make([]byte, 0, len(c.prefix)+len(key)), c.prefix...), key...)
+}
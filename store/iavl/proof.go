@@ -0,0 +1,202 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// ProofOpIAVLRange is the type of the proof of all the key/value pairs of a range.
+const ProofOpIAVLRange = "iavl:range"
+
+// MaxRangeProofKeys is the maximum number of keys a range proof can cover.
+const MaxRangeProofKeys = 1000
+
+var _ merkle.ProofOperator = RangeProofOp{}
+
+// RangeProofOp proves that a list of key/value pairs is exactly the content
+// of the range [start, end) of a tree. It takes the amino encoded []KVPair as
+// its single argument and produces the root hash of the tree.
+//
+// The proof is made of the proofs of consecutive leaves: the leaf right
+// before the range (unless the range starts the tree), every leaf of the
+// range and the leaf right after it (unless the range ends the tree).
+// NOTE: iavl range proofs are not used, as iavl skips the keys extending
+// another key when building them (e.g. a/1x after a/1).
+type RangeProofOp struct {
+	// Encoded in ProofOp.Key
+	start []byte
+
+	// To encode in ProofOp.Data.
+	End []byte `json:"end"` // nil for no end
+	// Leaves is empty for an empty tree
+	Leaves []*iavl.RangeProof `json:"leaves"`
+}
+
+// NewRangeProofOp returns the proof operation of the range [start, end).
+func NewRangeProofOp(start, end []byte, leaves []*iavl.RangeProof) RangeProofOp {
+	return RangeProofOp{start: start, End: end, Leaves: leaves}
+}
+
+// RangeProofOpDecoder returns a range proof operator from a given proof operation.
+func RangeProofOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLRange {
+		return nil, cmn.NewError("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLRange)
+	}
+	var op RangeProofOp
+	err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op)
+	if err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding ProofOp.Data into RangeProofOp")
+	}
+	return NewRangeProofOp(pop.Key, op.End, op.Leaves), nil
+}
+
+// ProofOp returns a merkle proof operation from a range proof operation.
+func (op RangeProofOp) ProofOp() merkle.ProofOp {
+	bz := cdc.MustMarshalBinaryLengthPrefixed(op)
+	return merkle.ProofOp{
+		Type: ProofOpIAVLRange,
+		Key:  op.start,
+		Data: bz,
+	}
+}
+
+// String implements the Stringer interface for a range proof operation.
+func (op RangeProofOp) String() string {
+	return fmt.Sprintf("RangeProofOp{%X-%X}", op.start, op.End)
+}
+
+// GetKey returns the start of the proven range.
+func (op RangeProofOp) GetKey() []byte {
+	return op.start
+}
+
+// Run verifies that the pairs in args are the content of the range and
+// returns the root hash of the tree.
+func (op RangeProofOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, errors.Errorf("expected 1 arg, got %v", len(args))
+	}
+	var kvs []types.KVPair
+	if err := cdc.UnmarshalBinaryLengthPrefixed(args[0], &kvs); err != nil {
+		return nil, errors.Wrap(err, "decoding range pairs")
+	}
+	// If the tree is nil, there are no leaves, and all ranges are empty.
+	if len(op.Leaves) == 0 {
+		if len(kvs) > 0 {
+			return nil, errors.New("pairs in a range of an empty tree")
+		}
+		return [][]byte{[]byte(nil)}, nil
+	}
+	root, err := op.verifyLeaves()
+	if err != nil {
+		return nil, err
+	}
+	i := 0
+	for _, proof := range op.Leaves {
+		leaf := proof.Leaves[0]
+		if !op.inRange(leaf.Key) {
+			continue
+		}
+		if i >= len(kvs) {
+			return nil, errors.Errorf("pair %X of the range is missing", leaf.Key)
+		}
+		if !bytes.Equal(kvs[i].Key, leaf.Key) || !bytes.Equal(tmhash.Sum(kvs[i].Value), leaf.ValueHash) {
+			return nil, errors.Errorf("pair %X doesn't match the proof", kvs[i].Key)
+		}
+		i++
+	}
+	if i < len(kvs) {
+		return nil, errors.Errorf("pair %X is not in the range", kvs[i].Key)
+	}
+	return [][]byte{root}, nil
+}
+
+func (op RangeProofOp) inRange(key []byte) bool {
+	return bytes.Compare(key, op.start) >= 0 && (op.End == nil || bytes.Compare(key, op.End) < 0)
+}
+
+// verifies that the leaves are consecutive leaves of the same tree, and that
+// no key of the range can be before or after them, and returns the root hash
+func (op RangeProofOp) verifyLeaves() (root []byte, err error) {
+	var index int64
+	for i, proof := range op.Leaves {
+		if proof == nil || len(proof.Leaves) != 1 || len(proof.InnerNodes) != 0 {
+			return nil, errors.Errorf("proof of leaf #%d is not the proof of a single leaf", i)
+		}
+		hash := proof.ComputeRootHash()
+		if err := proof.Verify(hash); err != nil {
+			return nil, errors.Wrapf(err, "computing root hash of leaf #%d", i)
+		}
+		if i == 0 {
+			root, index = hash, proof.LeftIndex()
+		} else if !bytes.Equal(hash, root) {
+			return nil, errors.Errorf("leaf #%d is not of the same tree", i)
+		} else if proof.LeftIndex() != index+int64(i) {
+			return nil, errors.Errorf("leaf #%d doesn't follow the previous one", i)
+		}
+	}
+	first := op.Leaves[0].Leaves[0].Key
+	last := op.Leaves[len(op.Leaves)-1].Leaves[0].Key
+	// the first leaf is before the range, or the first of the tree
+	if bytes.Compare(first, op.start) >= 0 && index != 0 {
+		return nil, errors.New("start of the range not proven")
+	}
+	// the last leaf is after the range, or the last of the tree
+	if (op.End == nil || bytes.Compare(last, op.End) < 0) && index+int64(len(op.Leaves)) != treeSize(op.Leaves[0]) {
+		return nil, errors.New("end of the range not proven")
+	}
+	return root, nil
+}
+
+// the size of the tree, as committed to by the root of the path of a leaf
+func treeSize(proof *iavl.RangeProof) int64 {
+	if len(proof.LeftPath) == 0 {
+		return 1
+	}
+	return proof.LeftPath[0].Size
+}
+
+// getRangeWithProof returns the pairs of the range [start, end) at version
+// with their proof, failing if the range has more than MaxRangeProofKeys keys.
+func getRangeWithProof(tree Tree, start, end []byte, version int64) ([]types.KVPair, []*iavl.RangeProof, error) {
+	immutable, err := tree.GetImmutable(version)
+	if err != nil {
+		return nil, nil, err
+	}
+	var kvs []types.KVPair
+	var keys [][]byte
+	immutable.IterateRange(nil, start, false, func(key, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+	immutable.IterateRange(start, end, true, func(key, value []byte) bool {
+		kvs = append(kvs, types.KVPair{Key: key, Value: value})
+		keys = append(keys, key)
+		return len(kvs) > MaxRangeProofKeys
+	})
+	if len(kvs) > MaxRangeProofKeys {
+		return nil, nil, fmt.Errorf("range has more than %d keys and can't be proven", MaxRangeProofKeys)
+	}
+	if end != nil {
+		immutable.IterateRange(end, nil, true, func(key, _ []byte) bool {
+			keys = append(keys, key)
+			return true
+		})
+	}
+	leaves := make([]*iavl.RangeProof, len(keys))
+	for i, key := range keys {
+		_, leaves[i], err = immutable.GetWithProof(key)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return kvs, leaves, nil
+}
@@ -0,0 +1,202 @@
+package iavl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The snapshot functions work on the raw node db of an IAVL tree, so a
+// restored tree is byte for byte identical to the exported one (same root
+// hash, same node versions).
+const (
+	nodeKeyPrefix = 'n' // n<hash>
+	rootKeyPrefix = 'r' // r<version>
+)
+
+func nodeKey(hash []byte) []byte {
+	return append([]byte{nodeKeyPrefix}, hash...)
+}
+
+func rootKey(version int64) []byte {
+	key := make([]byte, 9)
+	key[0] = rootKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+	return key
+}
+
+// ExportNodes walks the tree persisted in db at version and passes the root
+// entry followed by every reachable node (parents before children) to fn as
+// raw db key/value pairs.
+func ExportNodes(db dbm.DB, version int64, fn func(key, value []byte) error) error {
+	root := db.Get(rootKey(version))
+	if root == nil {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+	if err := fn(rootKey(version), root); err != nil {
+		return err
+	}
+	if len(root) == 0 {
+		return nil // empty tree
+	}
+	stack := [][]byte{root}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		bz := db.Get(nodeKey(hash))
+		if bz == nil {
+			return fmt.Errorf("node %X of version %d not found", hash, version)
+		}
+		if err := fn(nodeKey(hash), bz); err != nil {
+			return err
+		}
+		node, err := decodeSnapshotNode(bz)
+		if err != nil {
+			return err
+		}
+		if node.height > 0 {
+			stack = append(stack, node.rightHash, node.leftHash)
+		}
+	}
+	return nil
+}
+
+// NodeImporter writes exported nodes into an empty node db, verifying that
+// every node hashes to its key and belongs to the exported tree.
+type NodeImporter struct {
+	db      dbm.DB
+	batch   dbm.Batch
+	version int64
+	root    []byte
+	hasRoot bool
+	pending map[string]bool // hashes referenced by a received node but not yet received
+	size    int
+}
+
+// NewNodeImporter returns an importer of the tree at version into db.
+func NewNodeImporter(db dbm.DB, version int64) *NodeImporter {
+	return &NodeImporter{
+		db:      db,
+		batch:   db.NewBatch(),
+		version: version,
+		pending: make(map[string]bool),
+	}
+}
+
+// Add verifies and writes a raw key/value pair produced by ExportNodes.
+func (im *NodeImporter) Add(key, value []byte) error {
+	switch {
+	case !im.hasRoot:
+		if !bytes.Equal(key, rootKey(im.version)) {
+			return fmt.Errorf("expected root of version %d, got key %X", im.version, key)
+		}
+		im.root, im.hasRoot = append([]byte{}, value...), true
+		if len(value) > 0 {
+			im.pending[string(value)] = true
+		}
+	case len(key) > 0 && key[0] == nodeKeyPrefix:
+		hash := key[1:]
+		if !im.pending[string(hash)] {
+			return fmt.Errorf("unexpected node %X", hash)
+		}
+		node, err := decodeSnapshotNode(value)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(node.hash(), hash) {
+			return fmt.Errorf("node %X does not match its hash", hash)
+		}
+		delete(im.pending, string(hash))
+		if node.height > 0 {
+			im.pending[string(node.leftHash)] = true
+			im.pending[string(node.rightHash)] = true
+		}
+	default:
+		return fmt.Errorf("unexpected key %X", key)
+	}
+	im.batch.Set(key, value)
+	im.size++
+	// flush periodically to bound memory
+	if im.size%10000 == 0 {
+		im.batch.Write()
+		im.batch.Close()
+		im.batch = im.db.NewBatch()
+	}
+	return nil
+}
+
+// Commit checks that the whole tree was received, writes it and returns the root hash.
+func (im *NodeImporter) Commit() ([]byte, error) {
+	defer im.batch.Close()
+	if !im.hasRoot {
+		return nil, fmt.Errorf("root of version %d not received", im.version)
+	}
+	if len(im.pending) > 0 {
+		return nil, fmt.Errorf("%d nodes of version %d not received", len(im.pending), im.version)
+	}
+	im.batch.WriteSync()
+	return im.root, nil
+}
+
+// the subset of an iavl node needed to verify and walk it
+type snapshotNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+// decodes a node persisted by iavl (see iavl.MakeNode)
+func decodeSnapshotNode(bz []byte) (node snapshotNode, err error) {
+	var n int
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.height == 0 {
+		node.value, _, err = amino.DecodeByteSlice(bz)
+		return node, err
+	}
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	node.rightHash, _, err = amino.DecodeByteSlice(bz)
+	return node, err
+}
+
+// computes the node hash the same way iavl does
+func (node snapshotNode) hash() []byte {
+	var buf bytes.Buffer
+	// writes to a bytes.Buffer never fail
+	_ = amino.EncodeInt8(&buf, node.height)
+	_ = amino.EncodeVarint(&buf, node.size)
+	_ = amino.EncodeVarint(&buf, node.version)
+	if node.height == 0 {
+		_ = amino.EncodeByteSlice(&buf, node.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(node.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, node.leftHash)
+		_ = amino.EncodeByteSlice(&buf, node.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
@@ -43,6 +43,27 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 	return iavl, nil
 }
 
+// PruneStore deletes every version of the tree persisted in db that the
+// pruning options don't keep, relative to its latest version. It must not be
+// called on a db in use by a running node. Returns the number of versions deleted.
+func PruneStore(db dbm.DB, opts types.PruningOptions) (pruned int64, err error) {
+	tree := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	latest, err := tree.LoadVersion(0)
+	if err != nil {
+		return 0, err
+	}
+	for version := int64(1); version < latest; version++ {
+		if opts.ShouldKeep(version, latest) || !tree.VersionExists(version) {
+			continue
+		}
+		if err := tree.DeleteVersion(version); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
 //----------------------------------------
 
 var _ types.KVStore = (*Store)(nil)
@@ -64,6 +85,16 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// How often (in blocks) old versions are released. 0 or 1 releases on every commit.
+	pruneInterval int64
+
+	// The last version considered for release, so batched pruning resumes where it stopped.
+	lastPruned int64
+
+	// Versions that must not be released yet (e.g. while a snapshot exports them), with their number of holds.
+	heldVersions map[int64]int
+	heldMtx      sync.Mutex
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -108,16 +139,9 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
-	// Release an old version of history, if not a sync waypoint.
-	previous := version - 1
-	if st.numRecent < previous {
-		toRelease := previous - st.numRecent
-		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
-		}
+	// Release old versions of history, if not sync waypoints, once every pruning interval.
+	if st.pruneInterval <= 1 || version%st.pruneInterval == 0 {
+		st.pruneVersions(version)
 	}
 
 	return types.CommitID{
@@ -126,6 +150,59 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// releases every version that the pruning options don't keep since the last pruning
+func (st *Store) pruneVersions(latest int64) {
+	opts := types.NewCustomPruningOptions(st.numRecent, st.storeEvery, st.pruneInterval)
+	end := latest - st.numRecent
+	// stop at the oldest held version, the pruning resumes from it once it is released
+	if held := st.oldestHeldVersion(); held != 0 && held < end {
+		end = held
+	}
+	for toRelease := st.lastPruned + 1; toRelease < end; toRelease++ {
+		st.lastPruned = toRelease
+		if opts.ShouldKeep(toRelease, latest) || !st.tree.VersionExists(toRelease) {
+			continue
+		}
+		err := st.tree.DeleteVersion(toRelease)
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+}
+
+// HoldVersion keeps the version from being released by the pruning until ReleaseVersion is called.
+func (st *Store) HoldVersion(version int64) {
+	st.heldMtx.Lock()
+	defer st.heldMtx.Unlock()
+	if st.heldVersions == nil {
+		st.heldVersions = make(map[int64]int)
+	}
+	st.heldVersions[version]++
+}
+
+// ReleaseVersion removes a hold of HoldVersion, the version is then pruned as usual.
+func (st *Store) ReleaseVersion(version int64) {
+	st.heldMtx.Lock()
+	defer st.heldMtx.Unlock()
+	if st.heldVersions[version] <= 1 {
+		delete(st.heldVersions, version)
+		return
+	}
+	st.heldVersions[version]--
+}
+
+// returns the oldest held version, or 0 if no version is held
+func (st *Store) oldestHeldVersion() (oldest int64) {
+	st.heldMtx.Lock()
+	defer st.heldMtx.Unlock()
+	for version := range st.heldVersions {
+		if oldest == 0 || version < oldest {
+			oldest = version
+		}
+	}
+	return
+}
+
 // Implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
@@ -138,6 +215,7 @@ func (st *Store) LastCommitID() types.CommitID {
 func (st *Store) SetPruning(opt types.PruningOptions) {
 	st.numRecent = opt.KeepRecent()
 	st.storeEvery = opt.KeepEvery()
+	st.pruneInterval = opt.Interval()
 }
 
 // VersionExists returns whether or not a given version is stored.
@@ -284,6 +362,20 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
+		if req.Prove {
+			if !st.VersionExists(res.Height) {
+				res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+				break
+			}
+			KVs, leaves, err := getRangeWithProof(tree, subspace, types.PrefixEndBytes(subspace), res.Height)
+			if err != nil {
+				return serrors.ErrUnknownRequest(err.Error()).QueryResult()
+			}
+			res.Value = cdc.MustMarshalBinaryLengthPrefixed(KVs)
+			res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewRangeProofOp(subspace, types.PrefixEndBytes(subspace), leaves).ProofOp()}}
+			break
+		}
+
 		iterator := types.KVStorePrefixIterator(st, subspace)
 		for ; iterator.Valid(); iterator.Next() {
 			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
@@ -302,7 +394,15 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 //----------------------------------------
 
+const (
+	// number of items fetched by the first seek of an iterator, doubled on every following one
+	iavlIteratorMinBatch = 8
+	iavlIteratorMaxBatch = 1024
+)
+
 // Implements types.Iterator.
+// The iterator is a cursor over the immutable tree: it fetches the items in
+// batches with IterateRange, resuming after the last key of the previous batch.
 type iavlIterator struct {
 	// Underlying store
 	tree *iavl.ImmutableTree
@@ -313,64 +413,43 @@ type iavlIterator struct {
 	// Iteration order
 	ascending bool
 
-	// Channel to push iteration values.
-	iterCh chan cmn.KVPair
-
-	// Close this to release goroutine.
-	quitCh chan struct{}
-
-	// Close this to signal that state is initialized.
-	initCh chan struct{}
-
-	//----------------------------------------
-	// What follows are mutable state.
-	mtx sync.Mutex
-
-	invalid bool   // True once, true forever
-	key     []byte // The current key
-	value   []byte // The current value
+	// The current batch of items and the position of the current item in it
+	batch     []cmn.KVPair
+	pos       int
+	batchSize int
+	done      bool // true once the tree has no more items in the domain
 }
 
 var _ types.Iterator = (*iavlIterator)(nil)
 
 // newIAVLIterator will create a new iavlIterator.
-// CONTRACT: Caller must release the iavlIterator, as each one creates a new
-// goroutine.
 func newIAVLIterator(tree *iavl.ImmutableTree, start, end []byte, ascending bool) *iavlIterator {
 	iter := &iavlIterator{
 		tree:      tree,
 		start:     types.Cp(start),
 		end:       types.Cp(end),
 		ascending: ascending,
-		iterCh:    make(chan cmn.KVPair), // Set capacity > 0?
-		quitCh:    make(chan struct{}),
-		initCh:    make(chan struct{}),
+		batchSize: iavlIteratorMinBatch,
 	}
-	go iter.iterateRoutine()
-	go iter.initRoutine()
+	iter.fetch(iter.start, iter.end)
 	return iter
 }
 
-// Run this to funnel items from the tree to iterCh.
-func (iter *iavlIterator) iterateRoutine() {
-	iter.tree.IterateRange(
-		iter.start, iter.end, iter.ascending,
-		func(key, value []byte) bool {
-			select {
-			case <-iter.quitCh:
-				return true // done with iteration.
-			case iter.iterCh <- cmn.KVPair{Key: key, Value: value}:
-				return false // yay.
-			}
-		},
-	)
-	close(iter.iterCh) // done.
-}
-
-// Run this to fetch the first item.
-func (iter *iavlIterator) initRoutine() {
-	iter.receiveNext()
-	close(iter.initCh)
+// fetches the next batch of items in [start, end)
+func (iter *iavlIterator) fetch(start, end []byte) {
+	iter.batch, iter.pos = iter.batch[:0], 0
+	if iter.tree == nil {
+		iter.done = true
+		return
+	}
+	iter.tree.IterateRange(start, end, iter.ascending, func(key, value []byte) bool {
+		iter.batch = append(iter.batch, cmn.KVPair{Key: key, Value: value})
+		return len(iter.batch) == iter.batchSize
+	})
+	iter.done = len(iter.batch) < iter.batchSize
+	if iter.batchSize < iavlIteratorMaxBatch {
+		iter.batchSize *= 2
+	}
 }
 
 // Implements types.Iterator.
@@ -380,87 +459,47 @@ func (iter *iavlIterator) Domain() (start, end []byte) {
 
 // Implements types.Iterator.
 func (iter *iavlIterator) Valid() bool {
-	iter.waitInit()
-	iter.mtx.Lock()
-
-	validity := !iter.invalid
-	iter.mtx.Unlock()
-	return validity
+	return iter.pos < len(iter.batch)
 }
 
 // Implements types.Iterator.
 func (iter *iavlIterator) Next() {
-	iter.waitInit()
-	iter.mtx.Lock()
-	iter.assertIsValid(true)
-
-	iter.receiveNext()
-	iter.mtx.Unlock()
+	iter.assertIsValid()
+	iter.pos++
+	if iter.pos < len(iter.batch) || iter.done {
+		return
+	}
+	last := iter.batch[len(iter.batch)-1].Key
+	if iter.ascending {
+		// resume right after the last key
+		iter.fetch(append(types.Cp(last), 0), iter.end)
+	} else {
+		// resume right before the last key (end is exclusive)
+		iter.fetch(iter.start, last)
+	}
 }
 
 // Implements types.Iterator.
 func (iter *iavlIterator) Key() []byte {
-	iter.waitInit()
-	iter.mtx.Lock()
-	iter.assertIsValid(true)
-
-	key := iter.key
-	iter.mtx.Unlock()
-	return key
+	iter.assertIsValid()
+	return iter.batch[iter.pos].Key
 }
 
 // Implements types.Iterator.
 func (iter *iavlIterator) Value() []byte {
-	iter.waitInit()
-	iter.mtx.Lock()
-	iter.assertIsValid(true)
-
-	val := iter.value
-	iter.mtx.Unlock()
-	return val
+	iter.assertIsValid()
+	return iter.batch[iter.pos].Value
 }
 
 // Implements types.Iterator.
 func (iter *iavlIterator) Close() {
-	close(iter.quitCh)
-}
-
-//----------------------------------------
-
-func (iter *iavlIterator) setNext(key, value []byte) {
-	iter.assertIsValid(false)
-
-	iter.key = key
-	iter.value = value
-}
-
-func (iter *iavlIterator) setInvalid() {
-	iter.assertIsValid(false)
-
-	iter.invalid = true
+	iter.batch, iter.pos = nil, 0
+	iter.done = true
 }
 
-func (iter *iavlIterator) waitInit() {
-	<-iter.initCh
-}
-
-func (iter *iavlIterator) receiveNext() {
-	kvPair, ok := <-iter.iterCh
-	if ok {
-		iter.setNext(kvPair.Key, kvPair.Value)
-	} else {
-		iter.setInvalid()
-	}
-}
-
-// assertIsValid panics if the iterator is invalid. If unlockMutex is true,
-// it also unlocks the mutex before panicing, to prevent deadlocks in code that
-// recovers from panics
-func (iter *iavlIterator) assertIsValid(unlockMutex bool) {
-	if iter.invalid {
-		if unlockMutex {
-			iter.mtx.Unlock()
-		}
+// assertIsValid panics if the iterator is invalid.
+func (iter *iavlIterator) assertIsValid() {
+	if !iter.Valid() {
 		panic("invalid iterator")
 	}
 }
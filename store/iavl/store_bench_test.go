@@ -0,0 +1,260 @@
+package iavl
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/iavl"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// builds a tree of n items and returns it with its sorted keys
+func newBenchTree(t require.TestingT, n int) (*iavl.ImmutableTree, [][]byte) {
+	tree := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	keys := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		keys[i] = []byte(fmt.Sprintf("key%08d", i))
+		tree.Set(keys[i], cmn.RandBytes(64))
+	}
+	_, _, err := tree.SaveVersion()
+	require.NoError(t, err)
+	return tree.ImmutableTree, keys
+}
+
+func TestIAVLIteratorMatchesIterateRange(t *testing.T) {
+	tree, keys := newBenchTree(t, 3000)
+	ranges := [][2][]byte{
+		{nil, nil},
+		{keys[10], keys[2500]},
+		{keys[100], keys[101]},
+		{[]byte("key"), nil},
+		{nil, keys[7]},
+		{keys[5], keys[5]},
+	}
+	for _, r := range ranges {
+		for _, ascending := range []bool{true, false} {
+			var expected [][]byte
+			tree.IterateRange(r[0], r[1], ascending, func(key, _ []byte) bool {
+				expected = append(expected, key)
+				return false
+			})
+			var got [][]byte
+			iter := newIAVLIterator(tree, r[0], r[1], ascending)
+			for ; iter.Valid(); iter.Next() {
+				got = append(got, iter.Key())
+			}
+			iter.Close()
+			require.Equal(t, expected, got, "range %s-%s ascending %v", r[0], r[1], ascending)
+		}
+	}
+}
+
+func benchmarkIterator(b *testing.B, treeSize, rangeSize int, newIterator func(tree *iavl.ImmutableTree, start, end []byte) types.Iterator) {
+	tree, keys := newBenchTree(b, treeSize)
+	start, end := keys[treeSize/2-rangeSize/2], keys[treeSize/2+rangeSize/2]
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		iter := newIterator(tree, start, end)
+		for ; iter.Valid(); iter.Next() {
+			_ = iter.Value()
+		}
+		iter.Close()
+	}
+}
+
+func cursorIterator(tree *iavl.ImmutableTree, start, end []byte) types.Iterator {
+	return newIAVLIterator(tree, start, end, true)
+}
+
+func goroutineIteratorFn(tree *iavl.ImmutableTree, start, end []byte) types.Iterator {
+	return newGoroutineIterator(tree, start, end, true)
+}
+
+// validator sized ranges
+func BenchmarkIAVLIteratorValidators(b *testing.B) {
+	benchmarkIterator(b, 10000, 100, cursorIterator)
+}
+func BenchmarkGoroutineIteratorValidators(b *testing.B) {
+	benchmarkIterator(b, 10000, 100, goroutineIteratorFn)
+}
+
+// account sized ranges
+func BenchmarkIAVLIteratorAccounts(b *testing.B) {
+	benchmarkIterator(b, 100000, 50000, cursorIterator)
+}
+func BenchmarkGoroutineIteratorAccounts(b *testing.B) {
+	benchmarkIterator(b, 100000, 50000, goroutineIteratorFn)
+}
+
+// single item lookups through a prefix iterator
+func BenchmarkIAVLIteratorFirst(b *testing.B) {
+	benchmarkIterator(b, 10000, 2, cursorIterator)
+}
+func BenchmarkGoroutineIteratorFirst(b *testing.B) {
+	benchmarkIterator(b, 10000, 2, goroutineIteratorFn)
+}
+
+// goroutineIterator is the previous, goroutine based iavl iterator, kept to
+// benchmark the cursor based iavlIterator against it.
+type goroutineIterator struct {
+	// Underlying store
+	tree *iavl.ImmutableTree
+
+	// Domain
+	start, end []byte
+
+	// Iteration order
+	ascending bool
+
+	// Channel to push iteration values.
+	iterCh chan cmn.KVPair
+
+	// Close this to release goroutine.
+	quitCh chan struct{}
+
+	// Close this to signal that state is initialized.
+	initCh chan struct{}
+
+	//----------------------------------------
+	// What follows are mutable state.
+	mtx sync.Mutex
+
+	invalid bool   // True once, true forever
+	key     []byte // The current key
+	value   []byte // The current value
+}
+
+func newGoroutineIterator(tree *iavl.ImmutableTree, start, end []byte, ascending bool) *goroutineIterator {
+	iter := &goroutineIterator{
+		tree:      tree,
+		start:     types.Cp(start),
+		end:       types.Cp(end),
+		ascending: ascending,
+		iterCh:    make(chan cmn.KVPair), // Set capacity > 0?
+		quitCh:    make(chan struct{}),
+		initCh:    make(chan struct{}),
+	}
+	go iter.iterateRoutine()
+	go iter.initRoutine()
+	return iter
+}
+
+// Run this to funnel items from the tree to iterCh.
+func (iter *goroutineIterator) iterateRoutine() {
+	iter.tree.IterateRange(
+		iter.start, iter.end, iter.ascending,
+		func(key, value []byte) bool {
+			select {
+			case <-iter.quitCh:
+				return true // done with iteration.
+			case iter.iterCh <- cmn.KVPair{Key: key, Value: value}:
+				return false // yay.
+			}
+		},
+	)
+	close(iter.iterCh) // done.
+}
+
+// Run this to fetch the first item.
+func (iter *goroutineIterator) initRoutine() {
+	iter.receiveNext()
+	close(iter.initCh)
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Domain() (start, end []byte) {
+	return iter.start, iter.end
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Valid() bool {
+	iter.waitInit()
+	iter.mtx.Lock()
+
+	validity := !iter.invalid
+	iter.mtx.Unlock()
+	return validity
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Next() {
+	iter.waitInit()
+	iter.mtx.Lock()
+	iter.assertIsValid(true)
+
+	iter.receiveNext()
+	iter.mtx.Unlock()
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Key() []byte {
+	iter.waitInit()
+	iter.mtx.Lock()
+	iter.assertIsValid(true)
+
+	key := iter.key
+	iter.mtx.Unlock()
+	return key
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Value() []byte {
+	iter.waitInit()
+	iter.mtx.Lock()
+	iter.assertIsValid(true)
+
+	val := iter.value
+	iter.mtx.Unlock()
+	return val
+}
+
+// Implements types.Iterator.
+func (iter *goroutineIterator) Close() {
+	close(iter.quitCh)
+}
+
+//----------------------------------------
+
+func (iter *goroutineIterator) setNext(key, value []byte) {
+	iter.assertIsValid(false)
+
+	iter.key = key
+	iter.value = value
+}
+
+func (iter *goroutineIterator) setInvalid() {
+	iter.assertIsValid(false)
+
+	iter.invalid = true
+}
+
+func (iter *goroutineIterator) waitInit() {
+	<-iter.initCh
+}
+
+func (iter *goroutineIterator) receiveNext() {
+	kvPair, ok := <-iter.iterCh
+	if ok {
+		iter.setNext(kvPair.Key, kvPair.Value)
+	} else {
+		iter.setInvalid()
+	}
+}
+
+// assertIsValid panics if the iterator is invalid. If unlockMutex is true,
+// it also unlocks the mutex before panicing, to prevent deadlocks in code that
+// recovers from panics
+func (iter *goroutineIterator) assertIsValid(unlockMutex bool) {
+	if iter.invalid {
+		if unlockMutex {
+			iter.mtx.Unlock()
+		}
+		panic("invalid iterator")
+	}
+}
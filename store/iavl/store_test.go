@@ -467,6 +467,52 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(types.NewCustomPruningOptions(2, 0, 5))
+	for i := 1; i <= 9; i++ {
+		nextVersion(iavlStore)
+	}
+	// versions are only released at multiples of the pruning interval
+	for j := int64(1); j <= 2; j++ {
+		require.False(t, iavlStore.VersionExists(j), "version %d should be released at version 5", j)
+	}
+	for j := int64(3); j <= 9; j++ {
+		require.True(t, iavlStore.VersionExists(j), "version %d released before the pruning interval", j)
+	}
+	nextVersion(iavlStore)
+	for j := int64(1); j <= 7; j++ {
+		require.False(t, iavlStore.VersionExists(j), "version %d should be released at the pruning interval", j)
+	}
+	for j := int64(8); j <= 10; j++ {
+		require.True(t, iavlStore.VersionExists(j), "recent version %d should be kept", j)
+	}
+}
+
+func TestIAVLPruneStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(1))
+	for i := 1; i <= 20; i++ {
+		nextVersion(iavlStore)
+	}
+	pruned, err := PruneStore(db, types.NewPruningOptions(3, 5))
+	require.Nil(t, err)
+	require.Equal(t, int64(13), pruned)
+
+	tree = iavl.NewMutableTree(db, cacheSize)
+	_, err = tree.LoadVersion(0)
+	require.Nil(t, err)
+	for _, kept := range []int64{5, 10, 15, 17, 18, 19, 20} {
+		require.True(t, tree.VersionExists(kept), "version %d should be kept", kept)
+	}
+	for _, deleted := range []int64{1, 4, 11, 16} {
+		require.False(t, tree.VersionExists(deleted), "version %d should be pruned", deleted)
+	}
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -579,3 +625,23 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 		}
 	}
 }
+
+func TestIAVLHoldVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	nextVersion(iavlStore)
+	iavlStore.HoldVersion(1)
+	iavlStore.HoldVersion(1)
+	nextVersion(iavlStore)
+	nextVersion(iavlStore)
+	require.True(t, iavlStore.VersionExists(1), "a held version should not be pruned")
+	iavlStore.ReleaseVersion(1)
+	nextVersion(iavlStore)
+	require.True(t, iavlStore.VersionExists(1), "a version held twice should be released twice")
+	iavlStore.ReleaseVersion(1)
+	nextVersion(iavlStore)
+	for j := int64(1); j < 5; j++ {
+		require.False(t, iavlStore.VersionExists(j), "version %d should be pruned once released", j)
+	}
+}
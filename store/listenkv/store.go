@@ -0,0 +1,77 @@
+package listenkv
+
+import (
+	"io"
+
+	"github.com/pokt-network/posmint/store/cachekv"
+	"github.com/pokt-network/posmint/store/tracekv"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface and notifies its listeners of every
+// Set and Delete before delegating them to the parent KVStore.
+type Store struct {
+	parent    types.KVStore
+	storeKey  types.StoreKey
+	listeners []types.WriteListener
+}
+
+// NewStore returns a reference to a new listening store.
+func NewStore(parent types.KVStore, storeKey types.StoreKey, listeners []types.WriteListener) *Store {
+	return &Store{parent: parent, storeKey: storeKey, listeners: listeners}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Implements KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	s.parent.Set(key, value)
+	for _, l := range s.listeners {
+		l.OnWrite(s.storeKey, key, value, false)
+	}
+}
+
+// Implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	for _, l := range s.listeners {
+		l.OnWrite(s.storeKey, key, nil, true)
+	}
+}
+
+// Implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// Implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// Implements CacheWrapper. The writes of the cache are notified when it is written.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -9,6 +9,8 @@ import (
 // nolint
 type (
 	PruningOptions   = types.PruningOptions
+	StoreUpgrades    = types.StoreUpgrades
+	StoreRename      = types.StoreRename
 	Store            = types.Store
 	Committer        = types.Committer
 	CommitStore      = types.CommitStore
@@ -37,4 +39,11 @@ var (
 	PruneNothing    = types.PruneNothing
 	PruneEverything = types.PruneEverything
 	PruneSyncable   = types.PruneSyncable
+	PruneDefault    = types.PruneDefault
+
+	PruneEverythingBatched = types.PruneEverythingBatched
+	PruneSyncableBatched   = types.PruneSyncableBatched
+
+	NewCustomPruningOptions       = types.NewCustomPruningOptions
+	NewPruningOptionsFromStrategy = types.NewPruningOptionsFromStrategy
 )
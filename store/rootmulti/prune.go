@@ -0,0 +1,41 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/store/iavl"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// PruneStores deletes the old versions of every IAVL store committed in db
+// according to the pruning options, without loading the multistore. Only
+// stores in the multistore db are pruned (not those mounted with their own db).
+// It must not be called on a db in use by a running node.
+func PruneStores(db dbm.DB, opts types.PruningOptions) (map[string]int64, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	latest := getLatestVersion(db)
+	if latest == 0 {
+		return nil, fmt.Errorf("no committed version to prune")
+	}
+	cInfo, err := getCommitInfo(db, latest)
+	if err != nil {
+		return nil, err
+	}
+	pruned := make(map[string]int64)
+	for _, info := range cInfo.StoreInfos {
+		// stores that are not versioned (e.g. db adapters) have no versions to prune
+		if info.Core.CommitID.Version != latest {
+			continue
+		}
+		n, err := iavl.PruneStore(dbm.NewPrefixDB(db, storePrefix(info.Name)), opts)
+		if err != nil {
+			return pruned, fmt.Errorf("failed to prune store %s: %v", info.Name, err)
+		}
+		pruned[info.Name] = n
+	}
+	return pruned, nil
+}
@@ -0,0 +1,180 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pokt-network/posmint/store/iavl"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// maximum size of a single snapshot item
+const maxSnapshotItemSize = 64 << 20
+
+// snapshotItem is an entry of the snapshot stream. An item with a Store name
+// starts the data of that store, the following items are its raw db entries.
+type snapshotItem struct {
+	Store string
+	Key   []byte
+	Value []byte
+}
+
+// Implements Snapshotter. The stream starts with the commit info of the
+// version followed by the raw data of every committed store, ordered by name.
+// Only IAVL stores keep past versions, so a multistore with any other committed
+// store can't be snapshotted: their data could include writes made after height.
+func (rs *Store) Snapshot(height int64, w io.Writer) error {
+	cInfo, err := getCommitInfo(rs.db, height)
+	if err != nil {
+		return err
+	}
+	for _, info := range cInfo.StoreInfos {
+		key, ok := rs.keysByName[info.Name]
+		if !ok {
+			return fmt.Errorf("store %s is not mounted", info.Name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store %s of type %v isn't versioned and can't be snapshotted", info.Name, typ)
+		}
+	}
+	if _, err := cdc.MarshalBinaryLengthPrefixedWriter(w, cInfo); err != nil {
+		return err
+	}
+	infos := append([]storeInfo{}, cInfo.StoreInfos...)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	for _, info := range infos {
+		params := rs.storesParams[rs.keysByName[info.Name]]
+		if _, err := cdc.MarshalBinaryLengthPrefixedWriter(w, snapshotItem{Store: info.Name}); err != nil {
+			return err
+		}
+		write := func(k, v []byte) error {
+			_, err := cdc.MarshalBinaryLengthPrefixedWriter(w, snapshotItem{Key: k, Value: v})
+			return err
+		}
+		if err := iavl.ExportNodes(rs.storeDB(params), height, write); err != nil {
+			return fmt.Errorf("failed to snapshot store %s: %v", info.Name, err)
+		}
+	}
+	return nil
+}
+
+// Implements Snapshotter. The IAVL stores keep the version until it is released.
+func (rs *Store) HoldVersion(height int64) {
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			store.HoldVersion(height)
+		}
+	}
+}
+
+// Implements Snapshotter.
+func (rs *Store) ReleaseVersion(height int64) {
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			store.ReleaseVersion(height)
+		}
+	}
+}
+
+// Implements Snapshotter. The commit info is verified against the trusted app
+// hash, every store root against the commit info and every IAVL node against
+// its hash, so the restored store loads with the trusted LastCommitID.
+func (rs *Store) Restore(height int64, appHash []byte, r io.Reader) error {
+	if getLatestVersion(rs.db) != 0 {
+		return fmt.Errorf("can only restore a snapshot into an empty store")
+	}
+	br := bufio.NewReader(r)
+	var cInfo commitInfo
+	if _, err := cdc.UnmarshalBinaryLengthPrefixedReader(br, &cInfo, maxSnapshotItemSize); err != nil {
+		return fmt.Errorf("failed to read snapshot commit info: %v", err)
+	}
+	if cInfo.Version != height {
+		return fmt.Errorf("snapshot is of version %d, expected %d", cInfo.Version, height)
+	}
+	if !bytes.Equal(cInfo.Hash(), appHash) {
+		return fmt.Errorf("snapshot has app hash %X, expected %X", cInfo.Hash(), appHash)
+	}
+	infos := make(map[string]storeInfo)
+	for _, info := range cInfo.StoreInfos {
+		infos[info.Name] = info
+	}
+
+	restored := make(map[string]bool)
+	var current string
+	var importer *iavl.NodeImporter
+	// finish the store being restored and check it against the commit info
+	finish := func() error {
+		if importer == nil {
+			return nil
+		}
+		root, err := importer.Commit()
+		if err != nil {
+			return fmt.Errorf("failed to restore store %s: %v", current, err)
+		}
+		if !bytes.Equal(root, infos[current].Core.CommitID.Hash) {
+			return fmt.Errorf("store %s restored with hash %X, expected %X", current, root, infos[current].Core.CommitID.Hash)
+		}
+		importer = nil
+		return nil
+	}
+	for {
+		var item snapshotItem
+		_, err := cdc.UnmarshalBinaryLengthPrefixedReader(br, &item, maxSnapshotItemSize)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read snapshot item: %v", err)
+		}
+		if item.Store != "" {
+			if err := finish(); err != nil {
+				return err
+			}
+			if _, ok := infos[item.Store]; !ok || restored[item.Store] {
+				return fmt.Errorf("unexpected store %s in snapshot", item.Store)
+			}
+			key, ok := rs.keysByName[item.Store]
+			if !ok {
+				return fmt.Errorf("store %s is not mounted", item.Store)
+			}
+			params := rs.storesParams[key]
+			if params.typ != types.StoreTypeIAVL {
+				return fmt.Errorf("store %s of type %v can't be restored", item.Store, params.typ)
+			}
+			importer = iavl.NewNodeImporter(rs.storeDB(params), height)
+			current = item.Store
+			restored[current] = true
+			continue
+		}
+		if importer == nil {
+			return fmt.Errorf("snapshot data before any store")
+		}
+		if err := importer.Add(item.Key, item.Value); err != nil {
+			return fmt.Errorf("failed to restore store %s: %v", current, err)
+		}
+	}
+	if err := finish(); err != nil {
+		return err
+	}
+	for name := range infos {
+		if !restored[name] {
+			return fmt.Errorf("store %s missing from snapshot", name)
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, height, cInfo)
+	setLatestVersion(batch, height)
+	batch.WriteSync()
+
+	if err := rs.LoadVersion(height); err != nil {
+		return err
+	}
+	if rs.lastCommitID.Version != height {
+		return fmt.Errorf("restored version %d, expected %d", rs.lastCommitID.Version, height)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	db := dbm.NewMemDB()
+	source := newMultiStoreWithMounts(db)
+	require.Nil(t, source.LoadLatestVersion())
+	for version := 0; version < 3; version++ {
+		for i := 0; i < 50; i++ {
+			key := []byte(fmt.Sprintf("key%03d", i))
+			source.getStoreByName("store1").(types.KVStore).Set(key, []byte(fmt.Sprintf("value%d", version)))
+			if i%5 == 0 {
+				source.getStoreByName("store2").(types.KVStore).Set(key, key)
+			}
+		}
+		source.Commit()
+	}
+	commitID := source.LastCommitID()
+
+	var snapshot bytes.Buffer
+	require.Nil(t, source.Snapshot(commitID.Version, &snapshot))
+	require.Error(t, source.Snapshot(commitID.Version+1, &bytes.Buffer{}))
+
+	// restoring requires an empty store
+	require.Error(t, source.Restore(commitID.Version, commitID.Hash, bytes.NewReader(snapshot.Bytes())))
+
+	// the snapshot must match the trusted app hash
+	require.Error(t, newMultiStoreWithMounts(dbm.NewMemDB()).Restore(commitID.Version, []byte("untrusted"), bytes.NewReader(snapshot.Bytes())))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, target.Restore(commitID.Version, commitID.Hash, bytes.NewReader(snapshot.Bytes())))
+	require.Equal(t, commitID, target.LastCommitID())
+	require.Equal(t, []byte("value2"), target.getStoreByName("store1").(types.KVStore).Get([]byte("key007")))
+
+	// the restored store keeps committing like the source
+	source.getStoreByName("store3").(types.KVStore).Set([]byte("new"), []byte("data"))
+	target.getStoreByName("store3").(types.KVStore).Set([]byte("new"), []byte("data"))
+	require.Equal(t, source.Commit(), target.Commit())
+
+	// a corrupted snapshot is rejected
+	corrupted := append([]byte{}, snapshot.Bytes()...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	require.Error(t, newMultiStoreWithMounts(dbm.NewMemDB()).Restore(commitID.Version, commitID.Hash, bytes.NewReader(corrupted)))
+	truncated := snapshot.Bytes()[:snapshot.Len()/2]
+	require.Error(t, newMultiStoreWithMounts(dbm.NewMemDB()).Restore(commitID.Version, commitID.Hash, bytes.NewReader(truncated)))
+}
+
+func TestMultistoreSnapshotHeldVersion(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.pruningOpts = types.NewPruningOptions(0, 0)
+	require.Nil(t, store.LoadLatestVersion())
+	commit := func() int64 {
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", store.LastCommitID().Version)))
+		return store.Commit().Version
+	}
+
+	// a held version survives the pruning of the next commits until it is released
+	held := commit()
+	store.HoldVersion(held)
+	commit()
+	commit()
+	require.Nil(t, store.Snapshot(held, &bytes.Buffer{}))
+	store.ReleaseVersion(held)
+	commit()
+	require.Error(t, store.Snapshot(held, &bytes.Buffer{}))
+}
+
+func TestMultistoreSnapshotUnversionedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeDB, nil)
+	require.Nil(t, store.LoadLatestVersion())
+	store.getStoreByName("store4").(types.KVStore).Set([]byte("key"), []byte("value"))
+	version := store.Commit().Version
+
+	// the db store has no past versions, it could be snapshotted with later writes
+	require.Error(t, store.Snapshot(version, &bytes.Buffer{}))
+}
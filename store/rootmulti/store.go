@@ -14,6 +14,7 @@ import (
 	"github.com/pokt-network/posmint/store/dbadapter"
 	"github.com/pokt-network/posmint/store/errors"
 	"github.com/pokt-network/posmint/store/iavl"
+	"github.com/pokt-network/posmint/store/listenkv"
 	"github.com/pokt-network/posmint/store/tracekv"
 	"github.com/pokt-network/posmint/store/transient"
 	"github.com/pokt-network/posmint/store/types"
@@ -36,8 +37,13 @@ type Store struct {
 	keysByName   map[string]types.StoreKey
 	lazyLoading  bool
 
+	// store upgrades applied on load, recorded in the next commit info
+	pendingUpgrades *types.StoreUpgrades
+
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -103,14 +109,36 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 // Implements CommitMultiStore.
 func (rs *Store) LoadLatestVersion() error {
 	ver := getLatestVersion(rs.db)
-	return rs.LoadVersion(ver)
+	return rs.loadVersion(ver, nil)
+}
+
+// Implements CommitMultiStore.
+func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
+	ver := getLatestVersion(rs.db)
+	return rs.loadVersion(ver, upgrades)
 }
 
 // Implements CommitMultiStore.
 func (rs *Store) LoadVersion(ver int64) error {
+	return rs.loadVersion(ver, nil)
+}
+
+// Implements CommitMultiStore.
+func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades) error {
+	return rs.loadVersion(ver, upgrades)
+}
+
+func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	if err := upgrades.ValidateBasic(); err != nil {
+		return err
+	}
+
 	if ver == 0 {
 		// Special logic for version 0 where there is no need to get commit
 		// information.
+		if upgrades != nil && (len(upgrades.Renamed) != 0 || len(upgrades.Deleted) != 0) {
+			return fmt.Errorf("cannot rename or delete stores at version 0")
+		}
 		for key, storeParams := range rs.storesParams {
 			store, err := rs.loadCommitStoreFromParams(key, types.CommitID{}, storeParams)
 			if err != nil {
@@ -121,6 +149,7 @@ func (rs *Store) LoadVersion(ver int64) error {
 		}
 
 		rs.lastCommitID = types.CommitID{}
+		rs.pendingUpgrades = upgrades
 		return nil
 	}
 
@@ -130,19 +159,33 @@ func (rs *Store) LoadVersion(ver int64) error {
 	}
 
 	// convert StoreInfos slice to map
-	infos := make(map[types.StoreKey]storeInfo)
+	infosByName := make(map[string]storeInfo)
 	for _, storeInfo := range cInfo.StoreInfos {
-		infos[rs.nameToKey(storeInfo.Name)] = storeInfo
+		infosByName[storeInfo.Name] = storeInfo
 	}
 
+	if err := rs.checkUpgrades(ver, infosByName, upgrades); err != nil {
+		return err
+	}
+
+	// move the data of renamed stores and drop deleted stores before loading
+	rs.applyUpgrades(upgrades)
+
 	// load each Store
 	var newStores = make(map[types.StoreKey]types.CommitStore)
 	for key, storeParams := range rs.storesParams {
 		var id types.CommitID
 
-		info, ok := infos[key]
+		name := key.Name()
+		if oldName := upgrades.RenamedFrom(name); oldName != "" {
+			name = oldName
+		}
+		info, ok := infosByName[name]
 		if ok {
 			id = info.Core.CommitID
+		} else if storeParams.typ != types.StoreTypeTransient && !upgrades.IsAdded(key.Name()) {
+			// a mounted store that was never committed would otherwise be silently treated as empty
+			return fmt.Errorf("store %s is mounted but was not committed at version %d; declare it in StoreUpgrades.Added", key.Name(), ver)
 		}
 
 		store, err := rs.loadCommitStoreFromParams(key, id, storeParams)
@@ -155,10 +198,76 @@ func (rs *Store) LoadVersion(ver int64) error {
 
 	rs.lastCommitID = cInfo.CommitID()
 	rs.stores = newStores
+	rs.pendingUpgrades = upgrades
 
 	return nil
 }
 
+// checks the upgrades against the mounted stores and the stores committed at the version
+func (rs *Store) checkUpgrades(ver int64, infosByName map[string]storeInfo, upgrades *types.StoreUpgrades) error {
+	renamedFrom := make(map[string]bool)
+	if upgrades != nil {
+		for _, name := range upgrades.Added {
+			if _, ok := rs.keysByName[name]; !ok {
+				return fmt.Errorf("added store %s is not mounted", name)
+			}
+			if _, ok := infosByName[name]; ok {
+				return fmt.Errorf("added store %s already exists at version %d", name, ver)
+			}
+		}
+		for _, rename := range upgrades.Renamed {
+			if _, ok := infosByName[rename.OldKey]; !ok {
+				return fmt.Errorf("renamed store %s does not exist at version %d", rename.OldKey, ver)
+			}
+			if _, ok := rs.keysByName[rename.OldKey]; ok {
+				return fmt.Errorf("renamed store %s must not be mounted", rename.OldKey)
+			}
+			key, ok := rs.keysByName[rename.NewKey]
+			if !ok {
+				return fmt.Errorf("renamed store %s is not mounted", rename.NewKey)
+			}
+			if rs.storesParams[key].db != nil {
+				return fmt.Errorf("renamed store %s must not be mounted with its own db", rename.NewKey)
+			}
+			if _, ok := infosByName[rename.NewKey]; ok {
+				return fmt.Errorf("renamed store %s already exists at version %d", rename.NewKey, ver)
+			}
+			renamedFrom[rename.OldKey] = true
+		}
+		for _, name := range upgrades.Deleted {
+			if _, ok := infosByName[name]; !ok {
+				return fmt.Errorf("deleted store %s does not exist at version %d", name, ver)
+			}
+			if _, ok := rs.keysByName[name]; ok {
+				return fmt.Errorf("deleted store %s must not be mounted", name)
+			}
+		}
+	}
+	for name := range infosByName {
+		if _, ok := rs.keysByName[name]; !ok && !renamedFrom[name] && !upgrades.IsDeleted(name) {
+			return fmt.Errorf("store %s exists at version %d but is not mounted; declare it in StoreUpgrades.Deleted", name, ver)
+		}
+	}
+	return nil
+}
+
+// moves the IAVL data of renamed stores to their new prefix and drops the data of deleted stores
+// NOTE: this is idempotent, so a crash before the next commit can be recovered by loading with the same upgrades
+func (rs *Store) applyUpgrades(upgrades *types.StoreUpgrades) {
+	if upgrades == nil || (len(upgrades.Renamed) == 0 && len(upgrades.Deleted) == 0) {
+		return
+	}
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	for _, rename := range upgrades.Renamed {
+		moveStoreData(rs.db, batch, storePrefix(rename.OldKey), storePrefix(rename.NewKey))
+	}
+	for _, name := range upgrades.Deleted {
+		deleteStoreData(rs.db, batch, storePrefix(name))
+	}
+	batch.WriteSync()
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -187,6 +296,20 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners notified of the writes made to the store of key,
+// see CacheMultiStoreWithListening.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if rs.listeners == nil {
+		rs.listeners = make(map[types.StoreKey][]types.WriteListener)
+	}
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if writes to the store of key are notified to listeners.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -201,6 +324,7 @@ func (rs *Store) Commit() types.CommitID {
 	// Commit stores.
 	version := rs.lastCommitID.Version + 1
 	commitInfo := commitStores(version, rs.stores)
+	commitInfo.Upgrades = rs.pendingUpgrades
 
 	// Need to update atomically.
 	batch := rs.db.NewBatch()
@@ -215,6 +339,7 @@ func (rs *Store) Commit() types.CommitID {
 		Hash:    commitInfo.Hash(),
 	}
 	rs.lastCommitID = commitID
+	rs.pendingUpgrades = nil
 	return commitID
 }
 
@@ -241,6 +366,14 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
 }
 
+// CacheMultiStoreWithListening is analogous to CacheMultiStore except that the
+// writes made to the cache, directly or flushed from a nested cache, are
+// notified to the listeners of their store. Writes of nested caches that are
+// discarded are never notified.
+func (rs *Store) CacheMultiStoreWithListening() types.CacheMultiStore {
+	return rs.CacheMultiStore().(cachemulti.Store).SetListeners(rs.listeners)
+}
+
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
@@ -279,7 +412,8 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore implements the MultiStore interface. If tracing is enabled on the
 // Store, a wrapped TraceKVStore will be returned with the given
-// tracer, otherwise, the original KVStore will be returned.
+// tracer, otherwise, the original KVStore will be returned. The writes made to
+// the returned store are notified to the listeners of the store.
 // If the store does not exist, panics.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := rs.stores[key].(types.KVStore)
@@ -287,6 +421,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -336,7 +473,7 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	req.Path = subpath
 	res := queryable.Query(req)
 
-	if !req.Prove || !RequireProof(subpath) {
+	if !req.Prove || !RequireProof(subpath) || !res.IsOK() {
 		return res
 	}
 
@@ -381,14 +518,16 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 
 //----------------------------------------
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
-
+// returns the db holding the data of a store
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
+	return dbm.NewPrefixDB(rs.db, storePrefix(params.key.Name()))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
@@ -413,15 +552,6 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
-func (rs *Store) nameToKey(name string) types.StoreKey {
-	for key := range rs.storesParams {
-		if key.Name() == name {
-			return key
-		}
-	}
-	panic("Unknown name " + name)
-}
-
 //----------------------------------------
 // storeParams
 
@@ -442,6 +572,9 @@ type commitInfo struct {
 
 	// Store info for
 	StoreInfos []storeInfo
+
+	// Store upgrades applied since the previous version (not part of the hash)
+	Upgrades *types.StoreUpgrades
 }
 
 // Hash returns the simple merkle root hash of the stores sorted by name.
@@ -498,6 +631,31 @@ func (si storeInfo) Hash() []byte {
 //----------------------------------------
 // Misc.
 
+// returns the prefix of a store's data in the multistore db
+func storePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
+// copies every key under the old prefix to the new prefix and deletes the original
+func moveStoreData(db dbm.DB, batch dbm.Batch, oldPrefix, newPrefix []byte) {
+	iter := dbm.IteratePrefix(db, oldPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		newKey := append(append([]byte{}, newPrefix...), iter.Key()[len(oldPrefix):]...)
+		batch.Set(newKey, iter.Value())
+		batch.Delete(iter.Key())
+	}
+}
+
+// deletes every key under the prefix
+func deleteStoreData(db dbm.DB, batch dbm.Batch, prefix []byte) {
+	iter := dbm.IteratePrefix(db, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		batch.Delete(iter.Key())
+	}
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	var latest int64
 	latestBytes := db.Get([]byte(latestVersionKey))
@@ -247,6 +247,90 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreLoadWithUpgrade(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	k, v := []byte("wind"), []byte("blows")
+	store.getStoreByName("store1").(types.KVStore).Set(k, v)
+	store.getStoreByName("store2").(types.KVStore).Set(k, v)
+	store.getStoreByName("store3").(types.KVStore).Set(k, v)
+	commitID := store.Commit()
+
+	// a new store must be declared as added
+	newStore := func() *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.PruneSyncable
+		store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("restore2"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+		return store
+	}
+	require.Error(t, newStore().LoadLatestVersion())
+	require.Error(t, newStore().LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{"store4"}}))
+
+	upgrades := &types.StoreUpgrades{
+		Added:   []string{"store4"},
+		Renamed: []types.StoreRename{{OldKey: "store2", NewKey: "restore2"}},
+		Deleted: []string{"store3"},
+	}
+	upgraded := newStore()
+	err = upgraded.LoadLatestVersionAndUpgrade(upgrades)
+	require.Nil(t, err)
+	require.Equal(t, commitID, upgraded.LastCommitID())
+
+	// the renamed store keeps its data, the added store is empty
+	require.Equal(t, v, upgraded.getStoreByName("store1").(types.KVStore).Get(k))
+	require.Equal(t, v, upgraded.getStoreByName("restore2").(types.KVStore).Get(k))
+	require.Nil(t, upgraded.getStoreByName("store4").(types.KVStore).Get(k))
+	iter := dbm.IteratePrefix(db, storePrefix("store3"))
+	require.False(t, iter.Valid(), "deleted store data should be dropped")
+	iter.Close()
+
+	// the upgrade is recorded in the next commit info
+	commitID = upgraded.Commit()
+	cInfo, err := getCommitInfo(db, commitID.Version)
+	require.Nil(t, err)
+	require.Equal(t, upgrades, cInfo.Upgrades)
+	names := make([]string, 0, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		names = append(names, info.Name)
+	}
+	require.ElementsMatch(t, []string{"store1", "restore2", "store4"}, names)
+
+	// reloading no longer needs the upgrades
+	reloaded := newStore()
+	err = reloaded.LoadLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, commitID, reloaded.LastCommitID())
+	require.Equal(t, v, reloaded.getStoreByName("restore2").(types.KVStore).Get(k))
+}
+
+func TestPruneStores(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneNothing)
+	require.Nil(t, store.LoadLatestVersion())
+	for i := 0; i < 10; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte{byte(i)})
+		store.Commit()
+	}
+
+	pruned, err := PruneStores(db, types.NewPruningOptions(2, 0))
+	require.Nil(t, err)
+	require.Equal(t, map[string]int64{"store1": 7, "store2": 7, "store3": 7}, pruned)
+
+	store = newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	_, err = store.CacheMultiStoreWithVersion(7)
+	require.Error(t, err, "pruned version should not be loadable")
+	cms, err := store.CacheMultiStoreWithVersion(8)
+	require.Nil(t, err)
+	require.Equal(t, []byte{7}, cms.GetKVStore(store.keysByName["store1"]).Get([]byte("key")))
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -0,0 +1,136 @@
+// Package rwset records the keys read and written through a KVStore, so that
+// the accesses of concurrently executed txs can be checked for conflicts.
+package rwset
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pokt-network/posmint/store/cachekv"
+	"github.com/pokt-network/posmint/store/tracekv"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// Range is a key range read by an iterator, with the bounds of the iterator:
+// start is inclusive, end is exclusive, and nil means unbounded.
+type Range struct {
+	Start []byte
+	End   []byte
+}
+
+// Contains returns true if key is in the range.
+func (r Range) Contains(key []byte) bool {
+	return (r.Start == nil || bytes.Compare(key, r.Start) >= 0) &&
+		(r.End == nil || bytes.Compare(key, r.End) < 0)
+}
+
+// Set is the keys read and written through a Store. It is not safe for
+// concurrent use, a Set must be recorded by a single tx at a time.
+type Set struct {
+	Reads  map[string]struct{}
+	Ranges []Range
+	Writes map[string]struct{}
+}
+
+// NewSet returns an empty Set.
+func NewSet() *Set {
+	return &Set{
+		Reads:  make(map[string]struct{}),
+		Writes: make(map[string]struct{}),
+	}
+}
+
+// ReadsAny returns true if any of keys has been read, directly or by an
+// iterator.
+func (s *Set) ReadsAny(keys map[string]struct{}) bool {
+	for key := range keys {
+		if _, ok := s.Reads[key]; ok {
+			return true
+		}
+		for _, r := range s.Ranges {
+			if r.Contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface and records the keys of every read
+// and write in its Set before delegating them to the parent KVStore.
+type Store struct {
+	parent types.KVStore
+	set    *Set
+}
+
+// NewStore returns a reference to a new recording store.
+func NewStore(parent types.KVStore, set *Set) *Store {
+	return &Store{parent: parent, set: set}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.set.Reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.set.Reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Implements KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	s.set.Writes[string(key)] = struct{}{}
+	s.parent.Set(key, value)
+}
+
+// Implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.set.Writes[string(key)] = struct{}{}
+	s.parent.Delete(key)
+}
+
+// Implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.set.Ranges = append(s.set.Ranges, newRange(start, end))
+	return s.parent.Iterator(start, end)
+}
+
+// Implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.set.Ranges = append(s.set.Ranges, newRange(start, end))
+	return s.parent.ReverseIterator(start, end)
+}
+
+// Implements CacheWrapper.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// copies the bounds, which the caller may reuse
+func newRange(start, end []byte) Range {
+	r := Range{}
+	if start != nil {
+		r.Start = append([]byte{}, start...)
+	}
+	if end != nil {
+		r.End = append([]byte{}, end...)
+	}
+	return r
+}
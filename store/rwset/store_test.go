@@ -0,0 +1,69 @@
+package rwset_test
+
+import (
+	"testing"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/store/dbadapter"
+	"github.com/pokt-network/posmint/store/rwset"
+
+	"github.com/stretchr/testify/require"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keys(ks ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(ks))
+	for _, k := range ks {
+		m[k] = struct{}{}
+	}
+	return m
+}
+
+func TestStoreRecordsAccesses(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(bz("b"), bz("1"))
+	set := rwset.NewSet()
+	st := rwset.NewStore(mem, set)
+
+	require.Equal(t, bz("1"), st.Get(bz("b")))
+	require.False(t, st.Has(bz("c")))
+	st.Set(bz("d"), bz("2"))
+	st.Delete(bz("e"))
+	st.Iterator(bz("m"), bz("p")).Close()
+	st.ReverseIterator(bz("x"), nil).Close()
+
+	require.Equal(t, keys("b", "c"), set.Reads)
+	require.Equal(t, keys("d", "e"), set.Writes)
+	require.Equal(t, []rwset.Range{{Start: bz("m"), End: bz("p")}, {Start: bz("x")}}, set.Ranges)
+	require.Equal(t, bz("2"), mem.Get(bz("d")))
+}
+
+func TestSetReadsAny(t *testing.T) {
+	set := rwset.NewSet()
+	set.Reads["b"] = struct{}{}
+	set.Ranges = append(set.Ranges, rwset.Range{Start: bz("m"), End: bz("p")}, rwset.Range{Start: bz("x")})
+
+	cases := []struct {
+		written  map[string]struct{}
+		conflict bool
+	}{
+		{keys(), false},
+		{keys("a", "c"), false},
+		{keys("b"), true},
+		{keys("m"), true},
+		{keys("o"), true},
+		{keys("p"), false},
+		{keys("x"), true},
+		{keys("zzz"), true},
+		{keys("l", "q", "w"), false},
+	}
+	for i, tc := range cases {
+		require.Equal(t, tc.conflict, set.ReadsAny(tc.written), "case %d", i)
+	}
+
+	unbounded := rwset.NewSet()
+	unbounded.Ranges = append(unbounded.Ranges, rwset.Range{})
+	require.True(t, unbounded.ReadsAny(keys("anything")))
+}
@@ -0,0 +1,293 @@
+package snapshots
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+const (
+	// DefaultChunkSize is the maximum size of a snapshot chunk
+	DefaultChunkSize = 10 << 20
+	metadataFile     = "snapshot.json"
+)
+
+// Manager takes snapshots of a Snapshotter every interval heights, stores
+// them as compressed, hash-verified chunks under dir and keeps the most
+// recent ones. Snapshots are stored as dir/<height>/{snapshot.json,<chunk>}.
+type Manager struct {
+	store      types.Snapshotter
+	dir        string
+	interval   int64  // take a snapshot every interval heights (0 disables automatic snapshots)
+	keepRecent uint32 // number of snapshots to keep (0 keeps all)
+	chunkSize  int
+
+	mtx  sync.Mutex
+	busy bool
+}
+
+// NewManager creates a snapshot manager storing snapshots under dir.
+func NewManager(dir string, store types.Snapshotter, interval int64, keepRecent uint32) *Manager {
+	return &Manager{
+		store:      store,
+		dir:        dir,
+		interval:   interval,
+		keepRecent: keepRecent,
+		chunkSize:  DefaultChunkSize,
+	}
+}
+
+// SetChunkSize sets the maximum size of the chunks of new snapshots.
+func (m *Manager) SetChunkSize(size int) {
+	m.chunkSize = size
+}
+
+// ShouldSnapshot returns true if a snapshot should be taken at height.
+func (m *Manager) ShouldSnapshot(height int64) bool {
+	return m.interval > 0 && height > 0 && height%m.interval == 0
+}
+
+// begins an operation, failing if another one is in progress
+func (m *Manager) begin() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.busy {
+		return fmt.Errorf("a snapshot operation is already in progress")
+	}
+	m.busy = true
+	return nil
+}
+
+func (m *Manager) end() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.busy = false
+}
+
+// Create takes a snapshot of the state committed at height.
+func (m *Manager) Create(height int64) (*Snapshot, error) {
+	if err := m.begin(); err != nil {
+		return nil, err
+	}
+	defer m.end()
+	if _, err := os.Stat(m.path(height)); err == nil {
+		return nil, fmt.Errorf("snapshot at height %d already exists", height)
+	}
+	// write into a temporary directory so partial snapshots are never listed
+	tmpDir := m.path(height) + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	chunks := &chunkWriter{dir: tmpDir, chunkSize: m.chunkSize}
+	zw := gzip.NewWriter(chunks)
+	if err := m.store.Snapshot(height, zw); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	if err := chunks.Close(); err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{
+		Height:      height,
+		Format:      SnapshotFormat,
+		Chunks:      uint32(len(chunks.hashes)),
+		Hash:        hashChunkHashes(chunks.hashes),
+		ChunkHashes: chunks.hashes,
+	}
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, metadataFile), bz, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, m.path(height)); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Get returns the metadata of the snapshot at height.
+func (m *Manager) Get(height int64) (*Snapshot, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(m.path(height), metadataFile))
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, err
+	}
+	if err := snapshot.Validate(); err != nil {
+		return nil, err
+	}
+	if snapshot.Height != height {
+		return nil, fmt.Errorf("snapshot in %s is of height %d", m.path(height), snapshot.Height)
+	}
+	return &snapshot, nil
+}
+
+// List returns the snapshots stored on disk, newest first.
+func (m *Manager) List() ([]*Snapshot, error) {
+	entries, err := ioutil.ReadDir(m.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var snapshots []*Snapshot
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue // temporary or unrelated file
+		}
+		snapshot, err := m.Get(height)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Height > snapshots[j].Height })
+	return snapshots, nil
+}
+
+// LoadChunk returns a chunk of the snapshot at height, e.g. to serve it to a peer.
+func (m *Manager) LoadChunk(height int64, chunk uint32) ([]byte, error) {
+	return ioutil.ReadFile(m.chunkPath(height, chunk))
+}
+
+// Prune deletes all but the keepRecent most recent snapshots.
+func (m *Manager) Prune() error {
+	if m.keepRecent == 0 {
+		return nil
+	}
+	snapshots, err := m.List()
+	if err != nil {
+		return err
+	}
+	for i := int(m.keepRecent); i < len(snapshots); i++ {
+		if err := os.RemoveAll(m.path(snapshots[i].Height)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore verifies the chunks of the snapshot at height and restores them into the (empty) store.
+// The restored state is verified against appHash, the trusted app hash of the height (e.g. from a
+// light client), and not against the hashes in the snapshot.
+func (m *Manager) Restore(height int64, appHash []byte) error {
+	if err := m.begin(); err != nil {
+		return err
+	}
+	defer m.end()
+	snapshot, err := m.Get(height)
+	if err != nil {
+		return err
+	}
+	zr, err := gzip.NewReader(&chunkReader{manager: m, snapshot: snapshot})
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	return m.store.Restore(height, appHash, zr)
+}
+
+func (m *Manager) path(height int64) string {
+	return filepath.Join(m.dir, strconv.FormatInt(height, 10))
+}
+
+func (m *Manager) chunkPath(height int64, chunk uint32) string {
+	return filepath.Join(m.path(height), strconv.FormatUint(uint64(chunk), 10))
+}
+
+// chunkWriter splits a stream into chunk files of at most chunkSize bytes.
+type chunkWriter struct {
+	dir       string
+	chunkSize int
+	buf       []byte
+	hashes    [][]byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		n := w.chunkSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		if len(w.buf) == w.chunkSize {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Close writes the last partial chunk.
+func (w *chunkWriter) Close() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *chunkWriter) flush() error {
+	path := filepath.Join(w.dir, strconv.Itoa(len(w.hashes)))
+	if err := ioutil.WriteFile(path, w.buf, 0644); err != nil {
+		return err
+	}
+	hash := sha256.Sum256(w.buf)
+	w.hashes = append(w.hashes, hash[:])
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// chunkReader reads the chunks of a snapshot in order, verifying each one against its hash.
+type chunkReader struct {
+	manager  *Manager
+	snapshot *Snapshot
+	next     uint32
+	buf      []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.next >= r.snapshot.Chunks {
+			return 0, io.EOF
+		}
+		chunk, err := r.manager.LoadChunk(r.snapshot.Height, r.next)
+		if err != nil {
+			return 0, err
+		}
+		hash := sha256.Sum256(chunk)
+		if !bytes.Equal(hash[:], r.snapshot.ChunkHashes[r.next]) {
+			return 0, fmt.Errorf("chunk %d of snapshot %d does not match its hash", r.next, r.snapshot.Height)
+		}
+		r.buf = chunk
+		r.next++
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
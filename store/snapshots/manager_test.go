@@ -0,0 +1,84 @@
+package snapshots
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotter snapshots a fixed (incompressible) payload per height
+type mockSnapshotter struct {
+	restored map[int64][]byte
+}
+
+func (m *mockSnapshotter) payload(height int64) []byte {
+	var payload []byte
+	hash := sha256.Sum256([]byte(fmt.Sprintf("state at height %d", height)))
+	for i := 0; i < 32; i++ {
+		payload = append(payload, hash[:]...)
+		hash = sha256.Sum256(hash[:])
+	}
+	return payload
+}
+
+func (m *mockSnapshotter) Snapshot(height int64, w io.Writer) error {
+	_, err := w.Write(m.payload(height))
+	return err
+}
+
+func (m *mockSnapshotter) Restore(height int64, appHash []byte, r io.Reader) error {
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.restored[height] = bz
+	return nil
+}
+
+func (m *mockSnapshotter) HoldVersion(height int64) {}
+
+func (m *mockSnapshotter) ReleaseVersion(height int64) {}
+
+func TestManager(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	store := &mockSnapshotter{restored: make(map[int64][]byte)}
+	manager := NewManager(dir, store, 10, 2)
+	manager.SetChunkSize(64)
+
+	require.False(t, manager.ShouldSnapshot(5))
+	require.True(t, manager.ShouldSnapshot(20))
+
+	for _, height := range []int64{10, 20, 30} {
+		snapshot, err := manager.Create(height)
+		require.Nil(t, err)
+		require.True(t, snapshot.Chunks > 1, "the snapshot should be split into chunks")
+	}
+	_, err = manager.Create(30)
+	require.Error(t, err, "a snapshot can only be created once")
+
+	require.Nil(t, manager.Prune())
+	snapshots, err := manager.List()
+	require.Nil(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, int64(30), snapshots[0].Height)
+	require.Equal(t, int64(20), snapshots[1].Height)
+
+	require.Nil(t, manager.Restore(30, nil))
+	require.Equal(t, store.payload(30), store.restored[30])
+
+	// a corrupted chunk is rejected
+	chunk := filepath.Join(dir, "20", "0")
+	bz, err := ioutil.ReadFile(chunk)
+	require.Nil(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.Nil(t, ioutil.WriteFile(chunk, bz, 0644))
+	require.Error(t, manager.Restore(20, nil))
+}
@@ -0,0 +1,45 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// SnapshotFormat is the format of the chunks written by the Manager: a
+// gzip-compressed Snapshotter stream split into chunks.
+const SnapshotFormat uint32 = 1
+
+// Snapshot is the metadata of a snapshot stored on disk.
+type Snapshot struct {
+	Height      int64    `json:"height"`
+	Format      uint32   `json:"format"`
+	Chunks      uint32   `json:"chunks"`
+	Hash        []byte   `json:"hash"`         // hash of the concatenated chunk hashes
+	ChunkHashes [][]byte `json:"chunk_hashes"` // sha256 of each chunk
+}
+
+// Validate checks the snapshot metadata is consistent.
+func (s Snapshot) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("snapshot height must be positive, got %d", s.Height)
+	}
+	if s.Format != SnapshotFormat {
+		return fmt.Errorf("unknown snapshot format %d", s.Format)
+	}
+	if int(s.Chunks) != len(s.ChunkHashes) {
+		return fmt.Errorf("snapshot has %d chunks but %d chunk hashes", s.Chunks, len(s.ChunkHashes))
+	}
+	if !bytes.Equal(s.Hash, hashChunkHashes(s.ChunkHashes)) {
+		return fmt.Errorf("snapshot hash does not match its chunk hashes")
+	}
+	return nil
+}
+
+func hashChunkHashes(chunkHashes [][]byte) []byte {
+	hasher := sha256.New()
+	for _, hash := range chunkHashes {
+		hasher.Write(hash)
+	}
+	return hasher.Sum(nil)
+}
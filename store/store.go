@@ -13,14 +13,23 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 
 func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	switch strategy {
-	case "nothing":
+	case types.PruningOptionNothing:
 		opt = PruneNothing
-	case "everything":
+	case types.PruningOptionEverything:
 		opt = PruneEverything
-	case "syncable":
-		opt = PruneSyncable
+	case "syncable", types.PruningOptionDefault:
+		opt = PruneDefault
 	default:
-		opt = PruneSyncable
+		opt = PruneDefault
 	}
 	return
 }
+
+// PruneApplicationDB opens the application db of an existing data directory
+// and deletes the old IAVL versions that the pruning options don't keep.
+// The node using the data directory must be stopped.
+func PruneApplicationDB(dataDir string, backend dbm.DBBackendType, opts PruningOptions) (map[string]int64, error) {
+	db := dbm.NewDB("application", backend, dataDir)
+	defer db.Close()
+	return rootmulti.PruneStores(db, opts)
+}
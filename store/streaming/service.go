@@ -0,0 +1,119 @@
+// Package streaming streams the state changes committed by every block to
+// external consumers (e.g. indexers mirroring the chain state).
+//
+// The Service listens to the writes made to the deliver state of the app and
+// groups them by the ABCI call that made them. The writes of a DeliverTx are
+// only recorded when the tx cache is written, so the writes of failed
+// messages are never streamed, and a block is only passed to the sinks once
+// it is committed.
+package streaming
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+// TxChanges is the state changes made by a DeliverTx.
+type TxChanges struct {
+	Index   uint32              `json:"index"` // index of the tx in the block
+	TxHash  []byte              `json:"tx_hash"`
+	Code    uint32              `json:"code"` // result code, a failed tx may still have changes (e.g. fees)
+	Changes []types.StoreKVPair `json:"changes"`
+}
+
+// Block is the state changes committed by a block, grouped by ABCI call, in
+// the order they were made.
+type Block struct {
+	Height     int64               `json:"height"`
+	AppHash    []byte              `json:"app_hash"`
+	BeginBlock []types.StoreKVPair `json:"begin_block"` // includes the changes of InitChain in the first block
+	Txs        []TxChanges         `json:"txs"`
+	EndBlock   []types.StoreKVPair `json:"end_block"`
+}
+
+// Sink receives the committed blocks.
+type Sink interface {
+	// WriteBlock is called after each commit, in height order
+	WriteBlock(block Block) error
+	Close() error
+}
+
+// Service records the writes notified to it as a WriteListener and streams
+// them to its sinks once their block is committed. Its Listen methods must
+// be called by the app at the end of the corresponding ABCI calls.
+type Service struct {
+	sinks   []Sink
+	pending []types.StoreKVPair // writes not yet assigned to an ABCI call
+	block   Block
+}
+
+var _ types.WriteListener = (*Service)(nil)
+
+// NewService returns a streaming service writing to sinks.
+func NewService(sinks ...Sink) *Service {
+	return &Service{sinks: sinks}
+}
+
+// Implements WriteListener.
+func (s *Service) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) {
+	s.pending = append(s.pending, types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte{}, key...),
+		Value:    append([]byte(nil), value...),
+	})
+}
+
+// takes the writes recorded since the last call
+func (s *Service) flush() []types.StoreKVPair {
+	changes := s.pending
+	s.pending = nil
+	return changes
+}
+
+// ListenBeginBlock starts the block at height with the writes recorded so far.
+func (s *Service) ListenBeginBlock(height int64) {
+	s.block = Block{Height: height, BeginBlock: s.flush()}
+}
+
+// ListenDeliverTx assigns the writes recorded since the last call to the tx.
+func (s *Service) ListenDeliverTx(txBytes []byte, code uint32) {
+	s.block.Txs = append(s.block.Txs, TxChanges{
+		Index:   uint32(len(s.block.Txs)),
+		TxHash:  tmhash.Sum(txBytes),
+		Code:    code,
+		Changes: s.flush(),
+	})
+}
+
+// ListenEndBlock assigns the writes recorded since the last call to EndBlock.
+func (s *Service) ListenEndBlock() {
+	s.block.EndBlock = s.flush()
+}
+
+// ListenCommit passes the committed block to every sink. It returns the first
+// sink error, after trying all the sinks.
+func (s *Service) ListenCommit(appHash []byte) error {
+	block := s.block
+	block.AppHash = appHash
+	s.block = Block{}
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.WriteBlock(block); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close closes the sinks.
+func (s *Service) Close() error {
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
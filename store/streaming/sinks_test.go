@@ -0,0 +1,49 @@
+package streaming
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/posmint/store/types"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "blocks")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	key := types.NewKVStoreKey("store")
+	service := NewService(sink)
+	var blocks []Block
+	for height := int64(1); height <= 3; height++ {
+		service.OnWrite(key, []byte("begin"), []byte{byte(height)}, false)
+		service.ListenBeginBlock(height)
+		service.OnWrite(key, []byte("tx"), []byte{byte(height)}, false)
+		service.ListenDeliverTx([]byte{byte(height)}, 0)
+		service.OnWrite(key, []byte("begin"), nil, true)
+		service.ListenEndBlock()
+		blocks = append(blocks, service.block)
+		require.NoError(t, service.ListenCommit([]byte{byte(height)}))
+		blocks[height-1].AppHash = []byte{byte(height)}
+	}
+	require.NoError(t, service.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var read []Block
+	require.NoError(t, ReadBlocks(file, func(block Block) error {
+		read = append(read, block)
+		return nil
+	}))
+	require.Equal(t, blocks, read)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store", Key: []byte("tx"), Value: []byte{2}}, read[1].Txs[0].Changes[0])
+	require.True(t, read[2].EndBlock[0].Delete)
+}
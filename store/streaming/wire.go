@@ -0,0 +1,7 @@
+package streaming
+
+import (
+	"github.com/pokt-network/posmint/codec"
+)
+
+var cdc = codec.New()
@@ -0,0 +1,16 @@
+package types
+
+// WriteListener is notified of every write made to a listened store.
+type WriteListener interface {
+	// OnWrite is called with the key of the store, the written key and value,
+	// and whether the write is a delete (in which case value is nil).
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
+}
+
+// StoreKVPair is a write made to a store, as recorded by listeners.
+type StoreKVPair struct {
+	StoreKey string `json:"store_key"` // name of the store
+	Delete   bool   `json:"delete"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value"`
+}
@@ -1,10 +1,26 @@
 package types
 
+import "fmt"
+
+// Pruning strategy names
+const (
+	// PruningOptionDefault keeps the last 100 states plus every 10000th, pruning every 10 blocks
+	PruningOptionDefault = "default"
+	// PruningOptionEverything keeps only the current state, pruning every 10 blocks
+	PruningOptionEverything = "everything"
+	// PruningOptionNothing keeps every state (archive node)
+	PruningOptionNothing = "nothing"
+	// PruningOptionCustom uses explicitly provided keep-recent, keep-every and interval values
+	PruningOptionCustom = "custom"
+)
+
 // PruningStrategy specifies how old states will be deleted over time where
 // keepRecent can be used with keepEvery to create a pruning "strategy".
+// Deletion is batched and happens once every interval blocks.
 type PruningOptions struct {
 	keepRecent int64
 	keepEvery  int64
+	interval   int64
 }
 
 func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
@@ -14,6 +30,15 @@ func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
 	}
 }
 
+// NewCustomPruningOptions returns pruning options that prune every interval blocks.
+func NewCustomPruningOptions(keepRecent, keepEvery, interval int64) PruningOptions {
+	return PruningOptions{
+		keepRecent: keepRecent,
+		keepEvery:  keepEvery,
+		interval:   interval,
+	}
+}
+
 // How much recent state will be kept. Older state will be deleted.
 func (po PruningOptions) KeepRecent() int64 {
 	return po.keepRecent
@@ -24,6 +49,34 @@ func (po PruningOptions) KeepEvery() int64 {
 	return po.keepEvery
 }
 
+// How often (in blocks) old states are deleted. 0 or 1 prunes on every commit.
+func (po PruningOptions) Interval() int64 {
+	return po.interval
+}
+
+// Validate checks the options are consistent
+func (po PruningOptions) Validate() error {
+	if po.keepRecent < 0 || po.keepEvery < 0 || po.interval < 0 {
+		return fmt.Errorf("pruning options can't be negative: %s", po)
+	}
+	if po.keepEvery == 1 && po.interval != 0 {
+		return fmt.Errorf("pruning interval has no effect when every state is kept: %s", po)
+	}
+	return nil
+}
+
+// ShouldKeep returns true if the version must be kept when latest is the latest version
+func (po PruningOptions) ShouldKeep(version, latest int64) bool {
+	if version > latest-po.keepRecent-1 {
+		return true // the latest version and the keepRecent before it
+	}
+	return po.keepEvery != 0 && version%po.keepEvery == 0
+}
+
+func (po PruningOptions) String() string {
+	return fmt.Sprintf("keep-recent=%d, keep-every=%d, interval=%d", po.keepRecent, po.keepEvery, po.interval)
+}
+
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
@@ -32,4 +85,30 @@ var (
 	PruneNothing = NewPruningOptions(0, 1)
 	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 	PruneSyncable = NewPruningOptions(100, 10000)
+	// PruneDefault is the default pruning strategy
+	PruneDefault = PruneSyncable
+	// PruneEverythingBatched is PruneEverything deleting the saved states once every 10 blocks
+	PruneEverythingBatched = NewCustomPruningOptions(0, 0, 10)
+	// PruneSyncableBatched is PruneSyncable deleting the states not needed for state syncing once every 10 blocks
+	PruneSyncableBatched = NewCustomPruningOptions(100, 10000, 10)
 )
+
+// NewPruningOptionsFromStrategy returns the options of a named pruning strategy.
+// The default and everything strategies are batched, pruning every 10 blocks.
+// The custom strategy takes its values from keepRecent, keepEvery and interval.
+func NewPruningOptionsFromStrategy(strategy string, keepRecent, keepEvery, interval int64) (PruningOptions, error) {
+	var opts PruningOptions
+	switch strategy {
+	case PruningOptionDefault, "syncable":
+		opts = PruneSyncableBatched
+	case PruningOptionEverything:
+		opts = PruneEverythingBatched
+	case PruningOptionNothing:
+		opts = PruneNothing
+	case PruningOptionCustom:
+		opts = NewCustomPruningOptions(keepRecent, keepEvery, interval)
+	default:
+		return PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+	return opts, opts.Validate()
+}
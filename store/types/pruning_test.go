@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningOptionsFromStrategy(t *testing.T) {
+	testCases := []struct {
+		strategy  string
+		expected  PruningOptions
+		expectErr bool
+	}{
+		{PruningOptionDefault, PruneSyncableBatched, false},
+		{PruningOptionEverything, PruneEverythingBatched, false},
+		{PruningOptionNothing, PruneNothing, false},
+		{PruningOptionCustom, NewCustomPruningOptions(7, 50, 3), false},
+		{"unknown", PruningOptions{}, true},
+	}
+	for _, tc := range testCases {
+		opts, err := NewPruningOptionsFromStrategy(tc.strategy, 7, 50, 3)
+		if tc.expectErr {
+			require.Error(t, err, tc.strategy)
+			continue
+		}
+		require.NoError(t, err, tc.strategy)
+		require.Equal(t, tc.expected, opts, tc.strategy)
+	}
+	_, err := NewPruningOptionsFromStrategy(PruningOptionCustom, -1, 0, 0)
+	require.Error(t, err)
+}
+
+func TestPruningOptionsShouldKeep(t *testing.T) {
+	opts := NewCustomPruningOptions(2, 5, 10)
+	require.True(t, opts.ShouldKeep(20, 20), "latest version is always kept")
+	require.True(t, opts.ShouldKeep(18, 20), "recent versions are kept")
+	require.False(t, opts.ShouldKeep(17, 20))
+	require.True(t, opts.ShouldKeep(15, 20), "waypoints are kept")
+	require.True(t, PruneNothing.ShouldKeep(1, 20))
+	require.False(t, PruneEverything.ShouldKeep(19, 20))
+}
+
+func TestPruningOptionsIntervals(t *testing.T) {
+	// the existing strategies keep pruning on every commit
+	require.Equal(t, int64(0), PruneEverything.Interval())
+	require.Equal(t, int64(0), PruneSyncable.Interval())
+	require.Equal(t, PruneSyncable, PruneDefault)
+	require.Equal(t, int64(10), PruneEverythingBatched.Interval())
+	require.Equal(t, int64(10), PruneSyncableBatched.Interval())
+}
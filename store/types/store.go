@@ -99,6 +99,48 @@ type CommitMultiStore interface {
 	// must be idempotent (return the same commit id). Otherwise the behavior is
 	// undefined.
 	LoadVersion(ver int64) error
+
+	// LoadLatestVersionAndUpgrade will load the latest version, but also
+	// rename/delete/create sub-store keys, before registering all the keys
+	// in order to handle breaking formats in migrations
+	LoadLatestVersionAndUpgrade(upgrades *StoreUpgrades) error
+
+	// LoadVersionAndUpgrade will load the named version, but also
+	// rename/delete/create sub-store keys, before registering all the keys
+	// in order to handle breaking formats in migrations
+	LoadVersionAndUpgrade(ver int64, upgrades *StoreUpgrades) error
+
+	// Snapshot and restore committed versions for fast node bootstrap.
+	Snapshotter
+
+	// AddListeners adds listeners notified of the writes made to the store of key.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if writes to the store of key are notified to listeners.
+	ListeningEnabled(key StoreKey) bool
+
+	// CacheMultiStoreWithListening returns a CacheMultiStore whose writes,
+	// including the ones flushed to it by nested caches, are notified to the
+	// listeners of their store.
+	CacheMultiStoreWithListening() CacheMultiStore
+}
+
+// Snapshotter is something that can write a committed version of its state
+// to a stream and restore it from one.
+type Snapshotter interface {
+	// Snapshot writes the state committed at height to w.
+	Snapshot(height int64, w io.Writer) error
+
+	// Restore reads the state at height from r into an empty store and loads
+	// it, verifying it against the trusted app hash of the height.
+	Restore(height int64, appHash []byte, r io.Reader) error
+
+	// HoldVersion keeps the state committed at height from being pruned, so it
+	// can be snapshotted after later commits, until ReleaseVersion is called.
+	HoldVersion(height int64)
+
+	// ReleaseVersion lets the state committed at height be pruned again.
+	ReleaseVersion(height int64)
 }
 
 //---------subsp-------------------------------
@@ -0,0 +1,96 @@
+package types
+
+import "fmt"
+
+// StoreUpgrades defines a series of transformations to apply to the
+// multistore db upon load.
+type StoreUpgrades struct {
+	Added   []string      `json:"added"`
+	Renamed []StoreRename `json:"renamed"`
+	Deleted []string      `json:"deleted"`
+}
+
+// StoreRename defines a name change of a sub-store.
+// All data previously under a sub-store with OldKey will be moved to a
+// sub-store with NewKey. NewKey must be mounted and OldKey must not be.
+type StoreRename struct {
+	OldKey string `json:"old_key"`
+	NewKey string `json:"new_key"`
+}
+
+// IsAdded returns true if the given key should be added
+func (s *StoreUpgrades) IsAdded(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, added := range s.Added {
+		if key == added {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeleted returns true if the given key should be deleted
+func (s *StoreUpgrades) IsDeleted(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, d := range s.Deleted {
+		if d == key {
+			return true
+		}
+	}
+	return false
+}
+
+// RenamedFrom returns the oldKey if it was renamed
+// Returns "" if it was not renamed
+func (s *StoreUpgrades) RenamedFrom(key string) string {
+	if s == nil {
+		return ""
+	}
+	for _, re := range s.Renamed {
+		if re.NewKey == key {
+			return re.OldKey
+		}
+	}
+	return ""
+}
+
+// ValidateBasic checks that no store name appears in more than one upgrade
+func (s *StoreUpgrades) ValidateBasic() error {
+	if s == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	check := func(name string) error {
+		if name == "" {
+			return fmt.Errorf("store upgrade with empty store name")
+		}
+		if seen[name] {
+			return fmt.Errorf("store %s appears in more than one store upgrade", name)
+		}
+		seen[name] = true
+		return nil
+	}
+	for _, name := range s.Added {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+	for _, re := range s.Renamed {
+		if err := check(re.OldKey); err != nil {
+			return err
+		}
+		if err := check(re.NewKey); err != nil {
+			return err
+		}
+	}
+	for _, name := range s.Deleted {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
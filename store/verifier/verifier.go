@@ -0,0 +1,102 @@
+// Package verifier checks the responses of proven store queries against the
+// app hash of a trusted header, so a client doesn't have to trust the node
+// answering its queries.
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store/rootmulti"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+var cdc = codec.New()
+
+// KeyPath returns the ABCI query path of the value of a key of the store, to query with Prove set.
+func KeyPath(storeName string) string {
+	return fmt.Sprintf("/store/%s/key", storeName)
+}
+
+// SubspacePath returns the ABCI query path of the pairs of a prefix of the store, to query with Prove set.
+func SubspacePath(storeName string) string {
+	return fmt.Sprintf("/store/%s/subspace", storeName)
+}
+
+// Verifier verifies query responses against a trusted header. The app hash
+// of the header at height h commits to the state at height h-1, so the
+// responses must be of queries at the height of the header minus one.
+type Verifier struct {
+	header  tmtypes.Header
+	runtime *merkle.ProofRuntime
+}
+
+// NewVerifier returns a verifier of the responses of queries at header.Height-1.
+func NewVerifier(header tmtypes.Header) Verifier {
+	return Verifier{header: header, runtime: rootmulti.DefaultProofRuntime()}
+}
+
+// VerifyKey verifies the response of a KeyPath query of key and returns the
+// value of the key, nil if the key is proven absent.
+func (v Verifier) VerifyKey(storeName string, key []byte, res abci.ResponseQuery) ([]byte, error) {
+	if err := v.checkResponse(key, res); err != nil {
+		return nil, err
+	}
+	keyPath := proofKeyPath(storeName, key)
+	if res.Value == nil {
+		if err := v.runtime.VerifyAbsence(res.Proof, v.header.AppHash, keyPath); err != nil {
+			return nil, fmt.Errorf("failed to verify absence of key %X: %v", key, err)
+		}
+		return nil, nil
+	}
+	if err := v.runtime.VerifyValue(res.Proof, v.header.AppHash, keyPath, res.Value); err != nil {
+		return nil, fmt.Errorf("failed to verify value of key %X: %v", key, err)
+	}
+	return res.Value, nil
+}
+
+// VerifySubspace verifies the response of a SubspacePath query of prefix and
+// returns all the pairs of the store whose key starts with prefix.
+func (v Verifier) VerifySubspace(storeName string, prefix []byte, res abci.ResponseQuery) ([]types.KVPair, error) {
+	if err := v.checkResponse(prefix, res); err != nil {
+		return nil, err
+	}
+	var kvs []types.KVPair
+	if err := cdc.UnmarshalBinaryLengthPrefixed(res.Value, &kvs); err != nil {
+		return nil, fmt.Errorf("failed to decode the pairs of prefix %X: %v", prefix, err)
+	}
+	err := v.runtime.Verify(res.Proof, v.header.AppHash, proofKeyPath(storeName, prefix), [][]byte{res.Value})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the pairs of prefix %X: %v", prefix, err)
+	}
+	return kvs, nil
+}
+
+func (v Verifier) checkResponse(key []byte, res abci.ResponseQuery) error {
+	if !res.IsOK() {
+		return fmt.Errorf("query failed with code %d: %s", res.Code, res.Log)
+	}
+	if res.Height != v.header.Height-1 {
+		return fmt.Errorf("query at height %d can't be verified with the header of height %d", res.Height, v.header.Height)
+	}
+	if !bytes.Equal(res.Key, key) {
+		return fmt.Errorf("response is for key %X, expected %X", res.Key, key)
+	}
+	if res.Proof == nil || len(res.Proof.Ops) == 0 {
+		return fmt.Errorf("response has no proof")
+	}
+	return nil
+}
+
+// the key path the ops of the proof of key are checked against
+func proofKeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
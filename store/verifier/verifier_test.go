@@ -0,0 +1,148 @@
+package verifier
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/store/iavl"
+	"github.com/pokt-network/posmint/store/rootmulti"
+	"github.com/pokt-network/posmint/store/types"
+)
+
+func newStore(t *testing.T) (*rootmulti.Store, tmtypes.Header) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	key := types.NewKVStoreKey("bank")
+	store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("empty"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	kvs := store.GetKVStore(key)
+	for i := 0; i < 20; i++ {
+		kvs.Set([]byte(fmt.Sprintf("balance/%02d", i)), []byte(fmt.Sprintf("%d", i*100)))
+	}
+	// a key iavl leaves out of ranges ending at the successor of balance/05
+	kvs.Set([]byte("balance/05/frozen"), []byte("true"))
+	kvs.Set([]byte("supply"), []byte("19000"))
+	cid := store.Commit()
+	return store, tmtypes.Header{Height: cid.Version + 1, AppHash: cid.Hash}
+}
+
+// queries the store the same way the app routes /store queries
+func query(store *rootmulti.Store, storeName, subpath string, data []byte) abci.ResponseQuery {
+	return store.Query(abci.RequestQuery{Path: fmt.Sprintf("/%s/%s", storeName, subpath), Data: data, Prove: true})
+}
+
+func TestVerifyKey(t *testing.T) {
+	store, header := newStore(t)
+	v := NewVerifier(header)
+
+	res := query(store, "bank", "key", []byte("balance/05"))
+	value, err := v.VerifyKey("bank", []byte("balance/05"), res)
+	require.NoError(t, err)
+	require.Equal(t, []byte("500"), value)
+
+	// a tampered value fails
+	res.Value = []byte("5000000")
+	_, err = v.VerifyKey("bank", []byte("balance/05"), res)
+	require.Error(t, err)
+	// the response of another key fails
+	res = query(store, "bank", "key", []byte("balance/06"))
+	_, err = v.VerifyKey("bank", []byte("balance/05"), res)
+	require.Error(t, err)
+
+	// absence
+	res = query(store, "bank", "key", []byte("balance/99"))
+	value, err = v.VerifyKey("bank", []byte("balance/99"), res)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	res = query(store, "empty", "key", []byte("balance/99"))
+	value, err = v.VerifyKey("empty", []byte("balance/99"), res)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	// an absence can't be claimed for a present key
+	res = query(store, "bank", "key", []byte("balance/05"))
+	res.Value = nil
+	_, err = v.VerifyKey("bank", []byte("balance/05"), res)
+	require.Error(t, err)
+
+	// a header of another height or app hash fails
+	_, err = NewVerifier(tmtypes.Header{Height: header.Height + 1, AppHash: header.AppHash}).
+		VerifyKey("bank", []byte("balance/05"), query(store, "bank", "key", []byte("balance/05")))
+	require.Error(t, err)
+	_, err = NewVerifier(tmtypes.Header{Height: header.Height, AppHash: []byte("forged")}).
+		VerifyKey("bank", []byte("balance/05"), query(store, "bank", "key", []byte("balance/05")))
+	require.Error(t, err)
+}
+
+func TestVerifySubspace(t *testing.T) {
+	store, header := newStore(t)
+	v := NewVerifier(header)
+
+	for _, tc := range []struct {
+		store  string
+		prefix string
+		count  int
+	}{
+		{"bank", "balance/", 21},
+		{"bank", "balance/1", 10},
+		{"bank", "balance/05", 2},
+		{"bank", "a", 0},
+		{"bank", "balance/2", 0},
+		{"bank", "z", 0},
+		{"empty", "balance/", 0},
+	} {
+		res := query(store, tc.store, "subspace", []byte(tc.prefix))
+		kvs, err := v.VerifySubspace(tc.store, []byte(tc.prefix), res)
+		require.NoError(t, err, tc.prefix)
+		require.Len(t, kvs, tc.count, tc.prefix)
+		if tc.count == 0 {
+			continue
+		}
+
+		// dropping, altering or adding a pair fails
+		forged := func(kvs []types.KVPair) abci.ResponseQuery {
+			res := res
+			res.Value = cdc.MustMarshalBinaryLengthPrefixed(kvs)
+			return res
+		}
+		_, err = v.VerifySubspace(tc.store, []byte(tc.prefix), forged(kvs[1:]))
+		require.Error(t, err, tc.prefix)
+		altered := append([]types.KVPair{{Key: kvs[0].Key, Value: []byte("forged")}}, kvs[1:]...)
+		_, err = v.VerifySubspace(tc.store, []byte(tc.prefix), forged(altered))
+		require.Error(t, err, tc.prefix)
+		added := append(append([]types.KVPair{}, kvs...), types.KVPair{Key: append([]byte(tc.prefix), 'x'), Value: []byte("1")})
+		_, err = v.VerifySubspace(tc.store, []byte(tc.prefix), forged(added))
+		require.Error(t, err, tc.prefix)
+	}
+
+	// the proof of a prefix doesn't prove a wider one
+	res := query(store, "bank", "subspace", []byte("balance/1"))
+	res.Key = []byte("balance/")
+	_, err := v.VerifySubspace("bank", []byte("balance/"), res)
+	require.Error(t, err)
+}
+
+func TestSubspaceProofTooLarge(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	key := types.NewKVStoreKey("bank")
+	store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	kvs := store.GetKVStore(key)
+	for i := 0; i <= iavl.MaxRangeProofKeys; i++ {
+		kvs.Set([]byte(fmt.Sprintf("balance/%05d", i)), []byte{1})
+	}
+	cid := store.Commit()
+
+	res := query(store, "bank", "subspace", []byte("balance/"))
+	require.False(t, res.IsOK())
+	res = query(store, "bank", "subspace", []byte("balance/00"))
+	require.True(t, res.IsOK())
+	pairs, err := NewVerifier(tmtypes.Header{Height: cid.Version + 1, AppHash: cid.Hash}).
+		VerifySubspace("bank", []byte("balance/00"), res)
+	require.NoError(t, err)
+	require.Len(t, pairs, 1000)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StoreDecoder decodes an entry of a module store into its type name (e.g.
+// "validator") and its JSON form. It returns false if it doesn't know the key.
+type StoreDecoder func(key, value []byte) (typ string, decoded json.RawMessage, ok bool)
+
+// StateChange is a change a tx would make to a store entry, with the value
+// before and after the tx (nil when the entry doesn't exist), and their decoded
+// form when a decoder of the store knows the key.
+type StateChange struct {
+	StoreKey   string          `json:"store_key"`
+	Key        []byte          `json:"key"`
+	Type       string          `json:"type,omitempty"`
+	Before     []byte          `json:"before"`
+	After      []byte          `json:"after"`
+	DecodedOld json.RawMessage `json:"decoded_before,omitempty"`
+	DecodedNew json.RawMessage `json:"decoded_after,omitempty"`
+}
+
+// Deleted returns true if the tx would delete the entry.
+func (sc StateChange) Deleted() bool {
+	return sc.After == nil
+}
+
+// String implements fmt.Stringer.
+func (sc StateChange) String() string {
+	typ := sc.Type
+	if typ == "" {
+		typ = "unknown"
+	}
+	switch {
+	case sc.Before == nil:
+		return fmt.Sprintf("%s/%X (%s): created", sc.StoreKey, sc.Key, typ)
+	case sc.Deleted():
+		return fmt.Sprintf("%s/%X (%s): deleted", sc.StoreKey, sc.Key, typ)
+	default:
+		return fmt.Sprintf("%s/%X (%s): updated", sc.StoreKey, sc.Key, typ)
+	}
+}
+
+// StateDiff is the changes a tx would make to the state, sorted by store and
+// key.
+type StateDiff []StateChange
+
+// String implements fmt.Stringer.
+func (sd StateDiff) String() string {
+	lines := make([]string, len(sd))
+	for i, sc := range sd {
+		lines[i] = sc.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DryRunResult is the result of a tx simulated on a throwaway copy of the
+// state, with the changes it would make to it.
+type DryRunResult struct {
+	Result Result    `json:"result"`
+	Diff   StateDiff `json:"diff"`
+}
@@ -6,3 +6,42 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// CircuitBreaker is called before each message of a tx is handled, and rejects the
+// message with the error it returns, if any, so that messages can be disabled
+// without halting the chain.
+type CircuitBreaker func(ctx Context, msg Msg) Error
+
+// MsgPreHandler is called before each message of a tx is routed to its module
+// handler. It may consume gas or write to the store through ctx, and rejects
+// the message, and with it the whole tx, with the error it returns, if any.
+type MsgPreHandler func(ctx Context, msg Msg) Error
+
+// MsgPostHandler is called after each message of a tx has been handled, with the
+// result of its handler. The result it returns replaces the handler's one, so it
+// may add events to it or turn it into a failure.
+type MsgPostHandler func(ctx Context, msg Msg, result Result) Result
+
+// ChainMsgPreHandlers composes the pre handlers into one, which calls them in
+// order and stops at the first error.
+func ChainMsgPreHandlers(handlers ...MsgPreHandler) MsgPreHandler {
+	return func(ctx Context, msg Msg) Error {
+		for _, h := range handlers {
+			if err := h(ctx, msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ChainMsgPostHandlers composes the post handlers into one, which calls them in
+// order, each with the result returned by the previous one.
+func ChainMsgPostHandlers(handlers ...MsgPostHandler) MsgPostHandler {
+	return func(ctx Context, msg Msg, result Result) Result {
+		for _, h := range handlers {
+			result = h(ctx, msg, result)
+		}
+		return result
+	}
+}
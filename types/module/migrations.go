@@ -0,0 +1,143 @@
+package module
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// VersionStoreKey is the suggested name of the store key holding the version map
+const VersionStoreKey = "module_versions"
+
+// MigrationHandler migrates the state of a module from a consensus version to the next one
+type MigrationHandler func(ctx sdk.Context) error
+
+// HasMigrations is implemented by the modules with migrations, registered by NewManager.
+// Migrations returns the handler migrating from each version to the next one.
+type HasMigrations interface {
+	Migrations() map[uint64]MigrationHandler
+}
+
+// VersionMap is the consensus version of each module, by name
+type VersionMap map[string]uint64
+
+// SetVersionStoreKey sets the store the version map is kept in, which the app must mount
+func (m *Manager) SetVersionStoreKey(key sdk.StoreKey) {
+	m.versionKey = key
+}
+
+// RegisterMigration registers the handler migrating the state of a module from fromVersion to fromVersion+1
+func (m *Manager) RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error {
+	if _, ok := m.Modules[moduleName]; !ok {
+		return fmt.Errorf("migration registered for unknown module %s", moduleName)
+	}
+	if fromVersion == 0 {
+		return fmt.Errorf("migration of module %s registered from version 0, versions start at 1", moduleName)
+	}
+	if m.migrations[moduleName] == nil {
+		m.migrations[moduleName] = make(map[uint64]MigrationHandler)
+	}
+	if _, ok := m.migrations[moduleName][fromVersion]; ok {
+		return fmt.Errorf("migration of module %s from version %d already registered", moduleName, fromVersion)
+	}
+	m.migrations[moduleName][fromVersion] = handler
+	return nil
+}
+
+// GetConsensusVersions returns the current consensus version of every module
+func (m *Manager) GetConsensusVersions() VersionMap {
+	vm := make(VersionMap, len(m.Modules))
+	for name, module := range m.Modules {
+		vm[name] = module.ConsensusVersion()
+	}
+	return vm
+}
+
+// GetVersionMap returns the version map kept in the state, empty if there is no version store
+func (m *Manager) GetVersionMap(ctx sdk.Context) VersionMap {
+	vm := make(VersionMap)
+	if m.versionKey == nil {
+		return vm
+	}
+	iterator := ctx.KVStore(m.versionKey).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		vm[string(iterator.Key())] = binary.BigEndian.Uint64(iterator.Value())
+	}
+	return vm
+}
+
+// SetVersionMap keeps the version map in the state, a no-op if there is no version store
+func (m *Manager) SetVersionMap(ctx sdk.Context, vm VersionMap) {
+	if m.versionKey == nil {
+		return
+	}
+	store := ctx.KVStore(m.versionKey)
+	for name, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(name), bz)
+	}
+}
+
+// RunMigrations migrates every module in OrderMigrations from its version in fromVersions to its
+// consensus version, running the registered migrations one version at a time. A module missing
+// from fromVersions is new, and is initialized from its default genesis instead.
+// An empty fromVersions is the version map of a chain started before the modules were versioned,
+// so every module is migrated from version 1 and none is initialized.
+// It keeps and returns the updated version map. Meant to be called from an upgrade handler:
+//
+//	fromVersions := app.mm.GetVersionMap(ctx)
+//	if len(fromVersions) == 0 {
+//		// first upgrade since the modules are versioned: list the existing modules, so that
+//		// the modules added by the upgrade are initialized
+//		fromVersions = module.VersionMap{"auth": 1, "params": 1, "pos": 1}
+//	}
+//	if _, err := app.mm.RunMigrations(ctx, fromVersions); err != nil {
+//		panic(err)
+//	}
+//
+// NOTE: a failed migration leaves the state partly migrated, the caller must discard it
+func (m *Manager) RunMigrations(ctx sdk.Context, fromVersions VersionMap) (VersionMap, error) {
+	if len(fromVersions) == 0 {
+		fromVersions = make(VersionMap, len(m.Modules))
+		for name := range m.Modules {
+			fromVersions[name] = 1
+		}
+	}
+	updated := make(VersionMap, len(m.Modules))
+	for _, moduleName := range m.OrderMigrations {
+		module, ok := m.Modules[moduleName]
+		if !ok {
+			return nil, fmt.Errorf("unknown module %s in the migrations order", moduleName)
+		}
+		toVersion := module.ConsensusVersion()
+		fromVersion, exists := fromVersions[moduleName]
+		switch {
+		case !exists:
+			if genesis := module.DefaultGenesis(); genesis != nil {
+				if valUpdates := module.InitGenesis(ctx, genesis); len(valUpdates) > 0 {
+					return nil, fmt.Errorf("new module %s updates the validator set at its initialization", moduleName)
+				}
+			}
+			ctx.Logger().Info(fmt.Sprintf("initialized new module %s at version %d", moduleName, toVersion))
+		case fromVersion > toVersion:
+			return nil, fmt.Errorf("module %s can't be downgraded from version %d to %d", moduleName, fromVersion, toVersion)
+		default:
+			for version := fromVersion; version < toVersion; version++ {
+				handler, ok := m.migrations[moduleName][version]
+				if !ok {
+					return nil, fmt.Errorf("no migration registered for module %s from version %d", moduleName, version)
+				}
+				if err := handler(ctx); err != nil {
+					return nil, fmt.Errorf("migration of module %s from version %d failed: %s", moduleName, version, err)
+				}
+				ctx.Logger().Info(fmt.Sprintf("migrated module %s from version %d to %d", moduleName, version, version+1))
+			}
+		}
+		updated[moduleName] = toVersion
+	}
+	m.SetVersionMap(ctx, updated)
+	return updated, nil
+}
@@ -0,0 +1,127 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// genesis module at version, initialized with its default genesis
+type versionedModule struct {
+	name    string
+	version uint64
+	inits   *int
+}
+
+func (vm versionedModule) Name() string                           { return vm.name }
+func (versionedModule) RegisterCodec(*codec.Codec)                {}
+func (versionedModule) DefaultGenesis() json.RawMessage           { return json.RawMessage(`{}`) }
+func (versionedModule) ValidateGenesis(json.RawMessage) error     { return nil }
+func (versionedModule) ExportGenesis(sdk.Context) json.RawMessage { return nil }
+func (vm versionedModule) ConsensusVersion() uint64               { return vm.version }
+func (vm versionedModule) InitGenesis(sdk.Context, json.RawMessage) []abci.ValidatorUpdate {
+	*vm.inits++
+	return nil
+}
+
+func testVersionContext(t *testing.T) (sdk.Context, sdk.StoreKey) {
+	key := sdk.NewKVStoreKey(VersionStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	return sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger()), key
+}
+
+func TestRunMigrations(t *testing.T) {
+	ctx, key := testVersionContext(t)
+	inits := 0
+	mm := NewManager(
+		NewGenesisOnlyAppModule(versionedModule{"a", 3, &inits}),
+		NewGenesisOnlyAppModule(versionedModule{"b", 1, &inits}),
+		NewGenesisOnlyAppModule(versionedModule{"c", 1, &inits}),
+	)
+	mm.SetVersionStoreKey(key)
+
+	var ran []uint64
+	migrate := func(from uint64) MigrationHandler {
+		return func(sdk.Context) error {
+			ran = append(ran, from)
+			return nil
+		}
+	}
+	require.NoError(t, mm.RegisterMigration("a", 2, migrate(2)))
+	require.NoError(t, mm.RegisterMigration("a", 1, migrate(1)))
+	require.Error(t, mm.RegisterMigration("a", 1, migrate(1)), "already registered")
+	require.Error(t, mm.RegisterMigration("a", 0, migrate(0)), "versions start at 1")
+	require.Error(t, mm.RegisterMigration("unknown", 1, migrate(1)))
+
+	// c is a new module
+	updated, err := mm.RunMigrations(ctx, VersionMap{"a": 1, "b": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ran, "the migrations run in order")
+	require.Equal(t, 1, inits, "only the new module is initialized")
+	require.Equal(t, VersionMap{"a": 3, "b": 1, "c": 1}, updated)
+	require.Equal(t, updated, mm.GetVersionMap(ctx))
+
+	// nothing left to migrate
+	ran = nil
+	_, err = mm.RunMigrations(ctx, mm.GetVersionMap(ctx))
+	require.NoError(t, err)
+	require.Empty(t, ran)
+
+	_, err = mm.RunMigrations(ctx, VersionMap{"a": 4, "b": 1, "c": 1})
+	require.Error(t, err, "no downgrade")
+
+	mm.migrations["a"][2] = func(sdk.Context) error { return errors.New("failed") }
+	_, err = mm.RunMigrations(ctx, VersionMap{"a": 2, "b": 1, "c": 1})
+	require.Error(t, err)
+
+	delete(mm.migrations["a"], 2)
+	_, err = mm.RunMigrations(ctx, VersionMap{"a": 2, "b": 1, "c": 1})
+	require.Error(t, err, "missing migration")
+}
+
+func TestRunMigrationsBeforeVersioning(t *testing.T) {
+	ctx, key := testVersionContext(t)
+	inits := 0
+	mm := NewManager(
+		NewGenesisOnlyAppModule(versionedModule{"a", 2, &inits}),
+		NewGenesisOnlyAppModule(versionedModule{"b", 1, &inits}),
+	)
+	mm.SetVersionStoreKey(key)
+	migrated := false
+	require.NoError(t, mm.RegisterMigration("a", 1, func(sdk.Context) error {
+		migrated = true
+		return nil
+	}))
+
+	// no version map is kept by a chain started before versioning, its modules are at version 1
+	require.Empty(t, mm.GetVersionMap(ctx))
+	updated, err := mm.RunMigrations(ctx, mm.GetVersionMap(ctx))
+	require.NoError(t, err)
+	require.True(t, migrated)
+	require.Equal(t, 0, inits, "the existing modules are not reset to their default genesis")
+	require.Equal(t, VersionMap{"a": 2, "b": 1}, updated)
+	require.Equal(t, updated, mm.GetVersionMap(ctx))
+}
+
+func TestInitGenesisSetsVersionMap(t *testing.T) {
+	ctx, key := testVersionContext(t)
+	inits := 0
+	mm := NewManager(NewGenesisOnlyAppModule(versionedModule{"a", 3, &inits}))
+	mm.SetVersionStoreKey(key)
+
+	mm.InitGenesis(ctx, map[string]json.RawMessage{"a": json.RawMessage(`{}`)})
+	require.Equal(t, VersionMap{"a": 3}, mm.GetVersionMap(ctx))
+	require.Equal(t, mm.GetConsensusVersions(), mm.GetVersionMap(ctx))
+}
@@ -29,10 +29,9 @@ package module
 
 import (
 	"encoding/json"
-	"github.com/tendermint/tendermint/node"
+	"fmt"
 
 	"github.com/pokt-network/posmint/codec"
-	cryptokeys "github.com/pokt-network/posmint/crypto/keys"
 	sdk "github.com/pokt-network/posmint/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -93,6 +92,18 @@ type AppModuleGenesis interface {
 	ExportGenesis(sdk.Context) json.RawMessage
 }
 
+// ZeroHeightGenesisPreparer is implemented by the modules whose state must be
+// adjusted before being exported to relaunch a new chain from height zero
+type ZeroHeightGenesisPreparer interface {
+	PrepareForZeroHeightGenesis(sdk.Context)
+}
+
+// StoreDecoderProvider is implemented by the modules that can decode the entries
+// of their stores, e.g. in the state diff of a dry run
+type StoreDecoderProvider interface {
+	StoreDecoders() map[string]sdk.StoreDecoder // by store name
+}
+
 // AppModule is the standard form for an application module
 type AppModule interface {
 	AppModuleGenesis
@@ -100,9 +111,6 @@ type AppModule interface {
 	// registers
 	RegisterInvariants(sdk.InvariantRegistry)
 
-	GetTendermintNode() *node.Node  // return tendermint Node
-	GetKeybase() cryptokeys.Keybase // return keybase
-
 	// routes
 	Route() string
 	NewHandler() sdk.Handler
@@ -111,6 +119,9 @@ type AppModule interface {
 
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
+
+	// version of the state layout of the module, incremented with each migration
+	ConsensusVersion() uint64
 }
 
 //___________________________
@@ -126,10 +137,6 @@ func NewGenesisOnlyAppModule(amg AppModuleGenesis) AppModule {
 	}
 }
 
-func (GenesisOnlyAppModule) GetTendermintNode() *node.Node { return nil }
-
-func (GenesisOnlyAppModule) GetKeybase() cryptokeys.Keybase { return nil }
-
 // register invariants
 func (GenesisOnlyAppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
@@ -145,6 +152,21 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 // module querier
 func (gam GenesisOnlyAppModule) NewQuerierHandler() sdk.Querier { return nil }
 
+// module zero height genesis preparation, if the genesis module has one
+func (gam GenesisOnlyAppModule) PrepareForZeroHeightGenesis(ctx sdk.Context) {
+	if preparer, ok := gam.AppModuleGenesis.(ZeroHeightGenesisPreparer); ok {
+		preparer.PrepareForZeroHeightGenesis(ctx)
+	}
+}
+
+// module consensus version, the one of the genesis module if it has one
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 {
+	if versioned, ok := gam.AppModuleGenesis.(interface{ ConsensusVersion() uint64 }); ok {
+		return versioned.ConsensusVersion()
+	}
+	return 1
+}
+
 // module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -162,6 +184,10 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
+
+	migrations map[string]map[uint64]MigrationHandler // by module and version migrated from
+	versionKey sdk.StoreKey                           // store of the version map, nil to not store it
 }
 
 // NewModuleManager creates a new Manager object
@@ -174,13 +200,25 @@ func NewManager(modules ...AppModule) *Manager {
 		modulesStr = append(modulesStr, module.Name())
 	}
 
-	return &Manager{
+	m := &Manager{
 		Modules:            moduleMap,
 		OrderInitGenesis:   modulesStr,
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderMigrations:    modulesStr,
+		migrations:         make(map[string]map[uint64]MigrationHandler),
+	}
+	for _, module := range modules {
+		if migrator, ok := module.(HasMigrations); ok {
+			for fromVersion, handler := range migrator.Migrations() {
+				if err := m.RegisterMigration(module.Name(), fromVersion, handler); err != nil {
+					panic(err)
+				}
+			}
+		}
 	}
+	return m
 }
 
 // set the order of init genesis calls
@@ -203,6 +241,11 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// set the order of the module migrations
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	m.OrderMigrations = moduleNames
+}
+
 // register all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -222,6 +265,22 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// collect the store decoders of all modules, by store name
+func (m *Manager) StoreDecoders() map[string]sdk.StoreDecoder {
+	decoders := make(map[string]sdk.StoreDecoder)
+	for _, module := range m.Modules {
+		if provider, ok := module.(StoreDecoderProvider); ok {
+			for name, decoder := range provider.StoreDecoders() {
+				if _, exists := decoders[name]; exists {
+					panic(fmt.Sprintf("store decoder of %s registered twice", name))
+				}
+				decoders[name] = decoder
+			}
+		}
+	}
+	return decoders
+}
+
 // perform init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
@@ -240,6 +299,8 @@ func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMe
 			validatorUpdates = moduleValUpdates
 		}
 	}
+	// the genesis state is in the layout of the current versions
+	m.SetVersionMap(ctx, m.GetConsensusVersions())
 	return abci.ResponseInitChain{
 		Validators: validatorUpdates,
 	}
@@ -254,6 +315,17 @@ func (m *Manager) ExportGenesis(ctx sdk.Context) map[string]json.RawMessage {
 	return genesisData
 }
 
+// prepare the state of the modules for an export relaunching a new chain from height zero
+// NOTE: the modules are prepared in export genesis order, and the state is modified, so ctx
+// should be of a cache that is never written
+func (m *Manager) PrepareForZeroHeightGenesis(ctx sdk.Context) {
+	for _, moduleName := range m.OrderExportGenesis {
+		if preparer, ok := m.Modules[moduleName].(ZeroHeightGenesisPreparer); ok {
+			preparer.PrepareForZeroHeightGenesis(ctx)
+		}
+	}
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
@@ -4,3 +4,27 @@ import abci "github.com/tendermint/tendermint/abci/types"
 
 // Type for querier functions on keepers to implement to handle custom queries
 type Querier = func(ctx Context, path []string, req abci.RequestQuery) (res []byte, err Error)
+
+// MaxBatchQuerySize is the maximum number of queries in a batch query, a larger
+// batch is rejected as a whole
+const MaxBatchQuerySize = 100
+
+// BatchQuery is one of the custom queries of a batch query, with its path
+// (e.g. "custom/pos/validators") and data.
+type BatchQuery struct {
+	Path string `json:"path"`
+	Data []byte `json:"data"`
+}
+
+// BatchQueryResult is the result of one of the custom queries of a batch query.
+type BatchQueryResult struct {
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace,omitempty"`
+	Log       string `json:"log,omitempty"`
+	Value     []byte `json:"value"`
+}
+
+// IsOK returns true if the query succeeded.
+func (r BatchQueryResult) IsOK() bool {
+	return r.Code == uint32(CodeOK)
+}
@@ -9,6 +9,10 @@ import (
 // nolint - reexport
 type (
 	PruningOptions = types.PruningOptions
+	StoreUpgrades  = types.StoreUpgrades
+	StoreRename    = types.StoreRename
+	WriteListener  = types.WriteListener
+	StoreKVPair    = types.StoreKVPair
 )
 
 // nolint - reexport
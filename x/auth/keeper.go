@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -183,6 +185,22 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// DecodeStore decodes the accounts of the account store, see sdk.StoreDecoder.
+func (ak AccountKeeper) DecodeStore(key, value []byte) (string, json.RawMessage, bool) {
+	if !bytes.HasPrefix(key, types.AddressStoreKeyPrefix) {
+		return "", nil, false
+	}
+	var acc exported.Account
+	if err := ak.cdc.UnmarshalBinaryBare(value, &acc); err != nil {
+		return "", nil, false
+	}
+	bz, err := ak.cdc.MarshalJSON(acc)
+	if err != nil {
+		return "", nil, false
+	}
+	return "account", bz, true
+}
+
 // -----------------------------------------------------------------------------
 // Misc.
 
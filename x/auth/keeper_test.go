@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth/exported"
+	"github.com/pokt-network/posmint/x/auth/types"
 )
 
 func TestAccountMapperGetSet(t *testing.T) {
@@ -88,3 +90,24 @@ func TestGetParams(t *testing.T) {
 	newParams := input.ak.GetParams(input.ctx)
 	require.Equal(t, params, newParams)
 }
+
+func TestAccountKeeperDecodeStore(t *testing.T) {
+	input := setupTestInput()
+	addr := sdk.AccAddress([]byte("abcdefghijklmnopqrst"))
+	acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+	acc.SetSequence(7)
+	input.ak.SetAccount(input.ctx, acc)
+
+	key := types.AddressStoreKey(addr)
+	value := input.ctx.KVStore(input.ak.key).Get(key)
+	typ, bz, ok := input.ak.DecodeStore(key, value)
+	require.True(t, ok)
+	require.Equal(t, "account", typ)
+	var decoded exported.Account
+	require.NoError(t, input.ak.cdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, addr, decoded.GetAddress())
+	require.Equal(t, uint64(7), decoded.GetSequence())
+
+	_, _, ok = input.ak.DecodeStore(types.GlobalAccountNumberKey, value)
+	require.False(t, ok)
+}
@@ -2,8 +2,6 @@ package auth
 
 import (
 	"encoding/json"
-	"github.com/pokt-network/posmint/crypto/keys"
-	"github.com/tendermint/tendermint/node"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -14,8 +12,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.StoreDecoderProvider = AppModule{}
 )
 
 // AppModuleBasic app module basics object
@@ -51,17 +50,13 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 type AppModule struct {
 	AppModuleBasic
 	accountKeeper AccountKeeper
-	node          *node.Node
-	keybase       keys.Keybase
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(accountKeeper AccountKeeper, node *node.Node, keybase keys.Keybase) AppModule {
+func NewAppModule(accountKeeper AccountKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		accountKeeper:  accountKeeper,
-		node:           node,
-		keybase:        keybase,
 	}
 }
 
@@ -89,12 +84,9 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.accountKeeper)
 }
 
-func (am AppModule) GetTendermintNode() *node.Node {
-	return am.node
-}
-
-func (am AppModule) GetKeybase() keys.Keybase {
-	return am.keybase
+// StoreDecoders decodes the accounts of the account store
+func (am AppModule) StoreDecoders() map[string]sdk.StoreDecoder {
+	return map[string]sdk.StoreDecoder{types.StoreKey: am.accountKeeper.DecodeStore}
 }
 
 // InitGenesis module init-genesis
@@ -111,6 +103,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
+// ConsensusVersion returns the version of the auth module state.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock module begin-block
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
@@ -63,14 +63,26 @@ func ParamKeyTable() subspace.KeyTable {
 // nolint
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{KeyMaxMemoCharacters, &p.MaxMemoCharacters},
-		{KeyTxSigLimit, &p.TxSigLimit},
-		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
-		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
-		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		subspace.NewParamSetPair(KeyMaxMemoCharacters, &p.MaxMemoCharacters, validatePositiveUint64),
+		subspace.NewParamSetPair(KeyTxSigLimit, &p.TxSigLimit, validatePositiveUint64),
+		subspace.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validatePositiveUint64),
+		subspace.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validatePositiveUint64),
+		subspace.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validatePositiveUint64),
 	}
 }
 
+// the auth params are all limits and costs, which must be positive
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("must be positive: %d", v)
+	}
+	return nil
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -5,29 +5,31 @@ import (
 	"fmt"
 
 	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/crypto/keys"
 	sdk "github.com/pokt-network/posmint/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
-	"github.com/tendermint/tendermint/node"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 // CLIContext implements a typical CLI context created in SDK modules for
 // transaction handling and queries.
-type CLIContext struct { // TODO consider module passing clicontext instead of node and keybase
+type CLIContext struct {
 	Codec         *codec.Codec
 	Client        rpcclient.Client
+	Keybase       keys.Keybase
 	FromAddress   sdk.AccAddress
 	Passphrase    string
 	Height        int64
 	BroadcastMode BroadcastType
 }
 
-// NewCLIContext returns a new initialized CLIContext with parameters from the
-// command line using Viper. It takes a key name or address and populates the FromName and
-// FromAddress field accordingly.
-func NewCLIContext(node *node.Node, fromAddress sdk.AccAddress, passphrase string) CLIContext {
+// NewCLIContext returns a new initialized CLIContext using the client and
+// keybase of the given ClientContext. It takes an address and passphrase and
+// populates the FromAddress and Passphrase fields accordingly.
+func NewCLIContext(clientCtx ClientContext, fromAddress sdk.AccAddress, passphrase string) CLIContext {
 	return CLIContext{
-		Client:      rpcclient.NewLocal(node),
+		Client:      clientCtx.Client(),
+		Keybase:     clientCtx.Keybase(),
 		Passphrase:  passphrase,
 		FromAddress: fromAddress,
 	}
@@ -193,6 +195,22 @@ func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sd
 	return
 }
 
+// QueryBatch performs the custom queries to a Tendermint node in a single
+// request. It returns their results, made on the state of one height, and that
+// height upon success or an error if the batch query fails.
+func (ctx CLIContext) QueryBatch(queries []sdk.BatchQuery) (res []sdk.BatchQueryResult, height int64, err error) {
+	if len(queries) > sdk.MaxBatchQuerySize {
+		return res, height, fmt.Errorf("batch of %d queries exceeds the maximum of %d", len(queries), sdk.MaxBatchQuerySize)
+	}
+	resRaw, height, err := ctx.query("/app/batch", codec.Cdc.MustMarshalBinaryLengthPrefixed(queries))
+	if err != nil {
+		return res, height, err
+	}
+
+	err = codec.Cdc.UnmarshalBinaryLengthPrefixed(resRaw, &res)
+	return res, height, err
+}
+
 // query performs a query to a Tendermint node with the provided store name
 // and path. It returns the result and height of the query upon success
 // or an error if the query fails. If query height is invalid, an error will be returned.
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"github.com/pokt-network/posmint/crypto/keys"
+	"github.com/tendermint/tendermint/node"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// ClientContext provides the node connection and the keys used to build,
+// sign, broadcast and query transactions. It lives outside of the module
+// contract so the state machine never depends on a running node.
+type ClientContext interface {
+	Client() rpcclient.Client // client used for broadcasting and querying
+	Keybase() keys.Keybase    // keybase used for signing, may be nil
+}
+
+var _ ClientContext = clientContext{}
+
+// clientContext holds the client of a node, remote or in-process, and the keybase.
+type clientContext struct {
+	client  rpcclient.Client
+	keybase keys.Keybase
+}
+
+// NewRPCClientContext returns a ClientContext connected to the rpc endpoint
+// of a node, e.g. "tcp://localhost:26657".
+func NewRPCClientContext(remote string, keybase keys.Keybase) ClientContext {
+	return clientContext{
+		client:  rpcclient.NewHTTP(remote, "/websocket"),
+		keybase: keybase,
+	}
+}
+
+// NewLocalClientContext returns a ClientContext backed by an in-process node.
+func NewLocalClientContext(node *node.Node, keybase keys.Keybase) ClientContext {
+	return clientContext{
+		client:  rpcclient.NewLocal(node),
+		keybase: keybase,
+	}
+}
+
+func (c clientContext) Client() rpcclient.Client { return c.client }
+
+func (c clientContext) Keybase() keys.Keybase { return c.keybase }
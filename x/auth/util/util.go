@@ -36,6 +36,10 @@ func CompleteAndBroadcastTxCLI(txBldr auth.TxBuilder, cliCtx CLIContext, msgs []
 	if err != nil {
 		return nil, err
 	}
+	// fall back to the keybase of the client context
+	if txBldr.Keybase() == nil {
+		txBldr = txBldr.WithKeybase(cliCtx.Keybase)
+	}
 	// build and sign the transaction
 	txBytes, err := txBldr.BuildAndSign(cliCtx.FromAddress, cliCtx.Passphrase, msgs)
 	if err != nil {
@@ -50,6 +54,31 @@ func CompleteAndBroadcastTxCLI(txBldr auth.TxBuilder, cliCtx CLIContext, msgs []
 	return &tx, nil
 }
 
+// DryRunTxCLI builds and signs a transaction with the supplied messages like
+// CompleteAndBroadcastTxCLI, but instead of broadcasting it, simulates it on a
+// node (via the /app/dry_run query) and returns the changes it would make to
+// the state along with its result.
+func DryRunTxCLI(txBldr auth.TxBuilder, cliCtx CLIContext, msgs []sdk.Msg) (sdk.DryRunResult, error) {
+	var res sdk.DryRunResult
+	txBldr, err := PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return res, err
+	}
+	if txBldr.Keybase() == nil {
+		txBldr = txBldr.WithKeybase(cliCtx.Keybase)
+	}
+	txBytes, err := txBldr.BuildAndSign(cliCtx.FromAddress, cliCtx.Passphrase, msgs)
+	if err != nil {
+		return res, err
+	}
+	rawRes, _, err := cliCtx.QueryWithData("/app/dry_run", txBytes)
+	if err != nil {
+		return res, err
+	}
+	err = codec.Cdc.UnmarshalBinaryLengthPrefixed(rawRes, &res)
+	return res, err
+}
+
 // CalculateGas simulates the execution of a transaction and returns
 // both the estimate obtained by the query and the adjusted amount.
 func CalculateGas(
@@ -91,6 +120,9 @@ func SignStdTx(txBldr auth.TxBuilder, cliCtx CLIContext, stdTx auth.StdTx, appen
 			return signedStdTx, err
 		}
 	}
+	if txBldr.Keybase() == nil {
+		txBldr = txBldr.WithKeybase(cliCtx.Keybase)
+	}
 	return txBldr.SignStdTx(addr, cliCtx.Passphrase, stdTx, appendSig)
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/crypto/keys"
 	sdk "github.com/pokt-network/posmint/types"
 	authtypes "github.com/pokt-network/posmint/x/auth/types"
 )
@@ -111,6 +112,20 @@ func TestReadStdTxFromFile(t *testing.T) {
 	require.Equal(t, decodedTx.Memo, "foomemo")
 }
 
+func TestNewCLIContextFromClientContext(t *testing.T) {
+	kb := keys.NewInMemory()
+	clientCtx := NewRPCClientContext("tcp://localhost:26657", kb)
+
+	cliCtx := NewCLIContext(clientCtx, addr, "passphrase")
+	require.Equal(t, clientCtx.Client(), cliCtx.Client)
+	require.Equal(t, kb, cliCtx.Keybase)
+	require.Equal(t, addr, cliCtx.GetFromAddress())
+
+	node, err := cliCtx.GetNode()
+	require.NoError(t, err)
+	require.NotNil(t, node)
+}
+
 func compareEncoders(t *testing.T, expected sdk.TxEncoder, actual sdk.TxEncoder) {
 	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
 	tx := authtypes.NewStdTx(msgs, sdk.Coins{}, []authtypes.StdSignature{}, "")
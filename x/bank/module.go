@@ -2,8 +2,6 @@ package bank
 
 import (
 	"encoding/json"
-	"github.com/pokt-network/posmint/crypto/keys"
-	"github.com/tendermint/tendermint/node"
 
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
@@ -48,18 +46,14 @@ type AppModule struct {
 	AppModuleBasic
 	keeper        Keeper
 	accountKeeper types.AccountKeeper
-	node          *node.Node
-	keybase       keys.Keybase
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper, node *node.Node, keybase keys.Keybase) AppModule {
+func NewAppModule(keeper Keeper, accountKeeper types.AccountKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
-		node:           node,
-		keybase:        keybase,
 	}
 }
 
@@ -80,13 +74,6 @@ func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper) }
 // module querier route name
 func (AppModule) QuerierRoute() string { return RouterKey }
 
-func (am AppModule) GetTendermintNode() *node.Node {
-	return am.node
-}
-func (am AppModule) GetKeybase() keys.Keybase {
-	return am.keybase
-}
-
 // module querier
 func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return keeper.NewQuerier(am.keeper)
@@ -106,6 +93,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(gs)
 }
 
+// ConsensusVersion returns the version of the bank module state.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // module begin-block
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
@@ -0,0 +1,63 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/pokt-network/posmint/x/circuit/types
+package circuit
+
+import (
+	"github.com/pokt-network/posmint/x/circuit/internal/keeper"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+)
+
+const (
+	DefaultCodespace  = types.DefaultCodespace
+	CodeInvalidInput  = types.CodeInvalidInput
+	CodeUnauthorized  = types.CodeUnauthorized
+	CodeMsgDisabled   = types.CodeMsgDisabled
+	CodeCircuitNotSet = types.CodeCircuitNotSet
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryDisabledMsgs = types.QueryDisabledMsgs
+	QueryParameters   = types.QueryParameters
+)
+
+var (
+	// functions aliases
+	RegisterCodec        = types.RegisterCodec
+	ErrNilAuthority      = types.ErrNilAuthority
+	ErrInvalidCircuit    = types.ErrInvalidCircuit
+	ErrNotAuthority      = types.ErrNotAuthority
+	ErrMsgDisabled       = types.ErrMsgDisabled
+	ErrCircuitNotTripped = types.ErrCircuitNotTripped
+	NewGenesisState      = types.NewGenesisState
+	DefaultGenesisState  = types.DefaultGenesisState
+	ValidateGenesis      = types.ValidateGenesis
+	DefaultParams        = types.DefaultParams
+	ParseFullRoute       = types.ParseFullRoute
+	ValidateCircuit      = types.ValidateCircuit
+	NewMsgTripCircuit    = types.NewMsgTripCircuit
+	NewMsgResetCircuit   = types.NewMsgResetCircuit
+	KeyForDisabledMsg    = types.KeyForDisabledMsg
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	ParamKeyTable        = keeper.ParamKeyTable
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	DisabledMsgKey        = types.DisabledMsgKey
+	KeyAuthorities        = types.KeyAuthorities
+	EventTypeTripCircuit  = types.EventTypeTripCircuit
+	EventTypeResetCircuit = types.EventTypeResetCircuit
+)
+
+type (
+	GenesisState    = types.GenesisState
+	Params          = types.Params
+	DisabledMsg     = types.DisabledMsg
+	MsgTripCircuit  = types.MsgTripCircuit
+	MsgResetCircuit = types.MsgResetCircuit
+	Keeper          = keeper.Keeper
+)
@@ -0,0 +1,24 @@
+package circuit
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/keeper"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+)
+
+// new circuit genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	for _, disabled := range data.DisabledMsgs {
+		keeper.SetDisabledMsg(ctx, disabled)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
+	disabledMsgs := keeper.GetAllDisabledMsgs(ctx)
+	if disabledMsgs == nil {
+		disabledMsgs = []types.DisabledMsg{}
+	}
+	return types.NewGenesisState(keeper.GetParams(ctx), disabledMsgs)
+}
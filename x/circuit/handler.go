@@ -0,0 +1,60 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/keeper"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgTripCircuit:
+			return handleMsgTripCircuit(ctx, msg, k)
+		case types.MsgResetCircuit:
+			return handleMsgResetCircuit(ctx, msg, k)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized circuit message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgTripCircuit(ctx sdk.Context, msg types.MsgTripCircuit, k keeper.Keeper) sdk.Result {
+	if !k.GetParams(ctx).IsAuthority(msg.Authority) {
+		return types.ErrNotAuthority(types.DefaultCodespace, msg.Authority).Result()
+	}
+	if err := k.TripCircuit(ctx, msg.Authority, msg.MsgRoute, msg.MsgType, msg.Reason); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgResetCircuit(ctx sdk.Context, msg types.MsgResetCircuit, k keeper.Keeper) sdk.Result {
+	if !k.GetParams(ctx).IsAuthority(msg.Authority) {
+		return types.ErrNotAuthority(types.DefaultCodespace, msg.Authority).Result()
+	}
+	if err := k.ResetCircuit(ctx, msg.MsgRoute, msg.MsgType); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
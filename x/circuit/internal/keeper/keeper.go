@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+	"github.com/pokt-network/posmint/x/params"
+)
+
+// Keeper - circuit keeper, holding the list of disabled msg routes and types
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdk.CodespaceType
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// returns true if the msg type of the route is disabled, by itself or by its whole route
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, route, msgType string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyForDisabledMsg(route, "")) || (msgType != "" && store.Has(types.KeyForDisabledMsg(route, msgType)))
+}
+
+// get the disabled msg of the circuit of route and msgType
+func (k Keeper) GetDisabledMsg(ctx sdk.Context, route, msgType string) (disabled types.DisabledMsg, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForDisabledMsg(route, msgType))
+	if value == nil {
+		return disabled, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &disabled)
+	return disabled, true
+}
+
+// set a disabled msg in the store
+func (k Keeper) SetDisabledMsg(ctx sdk.Context, disabled types.DisabledMsg) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(disabled)
+	store.Set(types.KeyForDisabledMsg(disabled.Route, disabled.Type), bz)
+}
+
+// iterate through the disabled msgs and perform the provided function
+func (k Keeper) IterateAndExecuteOverDisabledMsgs(ctx sdk.Context, handler func(disabled types.DisabledMsg) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DisabledMsgKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var disabled types.DisabledMsg
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &disabled)
+		if handler(disabled) {
+			break
+		}
+	}
+}
+
+// returns every disabled msg
+func (k Keeper) GetAllDisabledMsgs(ctx sdk.Context) (disabledMsgs []types.DisabledMsg) {
+	k.IterateAndExecuteOverDisabledMsgs(ctx, func(disabled types.DisabledMsg) (stop bool) {
+		disabledMsgs = append(disabledMsgs, disabled)
+		return false
+	})
+	return
+}
+
+// TripCircuit disables the msg type of the route, or every msg of the route if msgType is empty.
+// authority is empty when the circuit is tripped by a module (e.g. on a broken invariant).
+func (k Keeper) TripCircuit(ctx sdk.Context, authority sdk.AccAddress, route, msgType, reason string) sdk.Error {
+	if err := types.ValidateCircuit(route, msgType); err != nil {
+		return types.ErrInvalidCircuit(k.codespace, err)
+	}
+	k.SetDisabledMsg(ctx, types.DisabledMsg{
+		Route:     route,
+		Type:      msgType,
+		Reason:    reason,
+		TrippedBy: authority,
+		Height:    ctx.BlockHeight(),
+	})
+	k.Logger(ctx).Info(fmt.Sprintf("circuit tripped for %s/%s: %s", route, msgType, reason))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuit,
+			sdk.NewAttribute(types.AttributeKeyRoute, route),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// ResetCircuit enables back the msgs disabled by the circuit of route and msgType.
+// NOTE: resetting the circuit of a msg type doesn't enable it while its whole route is disabled
+func (k Keeper) ResetCircuit(ctx sdk.Context, route, msgType string) sdk.Error {
+	if _, found := k.GetDisabledMsg(ctx, route, msgType); !found {
+		return types.ErrCircuitNotTripped(k.codespace, route+"/"+msgType)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForDisabledMsg(route, msgType))
+	k.Logger(ctx).Info(fmt.Sprintf("circuit reset for %s/%s", route, msgType))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuit,
+			sdk.NewAttribute(types.AttributeKeyRoute, route),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+	return nil
+}
+
+// NewCircuitBreaker returns the circuit breaker of the app, rejecting the disabled msgs
+func (k Keeper) NewCircuitBreaker() sdk.CircuitBreaker {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+		if k.IsMsgDisabled(ctx, msg.Route(), msg.Type()) {
+			return types.ErrMsgDisabled(k.codespace, msg.Route(), msg.Type())
+		}
+		return nil
+	}
+}
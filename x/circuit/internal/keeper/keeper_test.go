@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+	"github.com/pokt-network/posmint/x/params"
+)
+
+// a msg of route pos and type msgType
+type testMsg struct {
+	msgType string
+}
+
+func (msg testMsg) Route() string                { return "pos" }
+func (msg testMsg) Type() string                 { return msg.msgType }
+func (msg testMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg testMsg) GetSignBytes() []byte         { return nil }
+func (msg testMsg) GetSigners() []sdk.AccAddress { return nil }
+
+func testKeeper(t *testing.T) (sdk.Context, Keeper) {
+	keyCircuit := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCircuit, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	k := NewKeeper(cdc, keyCircuit, paramsKeeper.Subspace(types.DefaultParamspace), types.DefaultCodespace)
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k
+}
+
+func TestTripAndResetCircuit(t *testing.T) {
+	ctx, k := testKeeper(t)
+	breaker := k.NewCircuitBreaker()
+	stake, unjail := testMsg{"stake_validator"}, testMsg{"unjail"}
+	require.Nil(t, breaker(ctx, stake))
+
+	// a single msg type
+	require.Nil(t, k.TripCircuit(ctx, nil, "pos", "stake_validator", "broken invariant"))
+	require.NotNil(t, breaker(ctx, stake))
+	require.Equal(t, types.CodeMsgDisabled, breaker(ctx, stake).Code())
+	require.Nil(t, breaker(ctx, unjail))
+	disabled, found := k.GetDisabledMsg(ctx, "pos", "stake_validator")
+	require.True(t, found)
+	require.Equal(t, int64(10), disabled.Height)
+	require.Equal(t, "pos/stake_validator", disabled.FullRoute())
+
+	// the whole route
+	require.Nil(t, k.TripCircuit(ctx, nil, "pos", "", "maintenance"))
+	require.NotNil(t, breaker(ctx, unjail))
+	require.Len(t, k.GetAllDisabledMsgs(ctx), 2)
+	require.Nil(t, k.ResetCircuit(ctx, "pos", ""))
+	require.Nil(t, breaker(ctx, unjail))
+	require.NotNil(t, breaker(ctx, stake), "the msg type circuit is still tripped")
+	require.Nil(t, k.ResetCircuit(ctx, "pos", "stake_validator"))
+	require.Nil(t, breaker(ctx, stake))
+	require.Empty(t, k.GetAllDisabledMsgs(ctx))
+
+	// errors
+	require.NotNil(t, k.ResetCircuit(ctx, "pos", "stake_validator"), "nothing to reset")
+	require.NotNil(t, k.TripCircuit(ctx, nil, types.RouterKey, "", "lock out"), "the circuit msgs can't be disabled")
+	require.NotNil(t, k.TripCircuit(ctx, nil, "", "unjail", "no route"))
+}
+
+func TestQuerier(t *testing.T) {
+	ctx, k := testKeeper(t)
+	querier := NewQuerier(k)
+	require.Nil(t, k.TripCircuit(ctx, nil, "pos", "", "maintenance"))
+
+	bz, err := querier(ctx, []string{types.QueryDisabledMsgs}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var disabledMsgs []types.DisabledMsg
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &disabledMsgs))
+	require.Len(t, disabledMsgs, 1)
+	require.Equal(t, "maintenance", disabledMsgs[0].Reason)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
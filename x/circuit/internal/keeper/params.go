@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+	"github.com/pokt-network/posmint/x/params"
+)
+
+// ParamTable for circuit module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+// Authorities - accounts allowed to trip and reset circuits
+func (k Keeper) Authorities(ctx sdk.Context) (res []sdk.AccAddress) {
+	k.paramSpace.Get(ctx, types.KeyAuthorities, &res)
+	return
+}
+
+// Get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.Params{
+		Authorities: k.Authorities(ctx),
+	}
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// creates a querier for circuit REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case types.QueryDisabledMsgs:
+			return queryDisabledMsgs(ctx, k)
+		case types.QueryParameters:
+			return queryParameters(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown circuit query endpoint")
+		}
+	}
+}
+
+func queryDisabledMsgs(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	disabledMsgs := k.GetAllDisabledMsgs(ctx)
+	if disabledMsgs == nil {
+		disabledMsgs = []types.DisabledMsg{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, disabledMsgs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/pokt-network/posmint/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgTripCircuit{}, "posmint/MsgTripCircuit", nil)
+	cdc.RegisterConcrete(MsgResetCircuit{}, "posmint/MsgResetCircuit", nil)
+}
+
+// generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// DisabledMsg - a msg route, or a single msg type of the route, disabled by the circuit breaker
+type DisabledMsg struct {
+	Route     string         `json:"route" yaml:"route"`           // msg route
+	Type      string         `json:"type" yaml:"type"`             // msg type, empty for every msg of the route
+	Reason    string         `json:"reason" yaml:"reason"`         // why the msgs were disabled
+	TrippedBy sdk.AccAddress `json:"tripped_by" yaml:"tripped_by"` // authority that disabled the msgs, empty if done by a module
+	Height    int64          `json:"height" yaml:"height"`         // block height the msgs were disabled at
+}
+
+// returns the full route of the disabled msgs, route/type or route for a whole route
+func (d DisabledMsg) FullRoute() string {
+	if d.Type == "" {
+		return d.Route
+	}
+	return d.Route + "/" + d.Type
+}
+
+// String returns a human readable string representation of a disabled msg.
+func (d DisabledMsg) String() string {
+	return fmt.Sprintf(`Disabled Msg:
+  Route:      %s
+  Type:       %s
+  Reason:     %s
+  Tripped By: %s
+  Height:     %d`, d.Route, d.Type, d.Reason, d.TrippedBy, d.Height)
+}
+
+// ParseFullRoute returns the route and msg type of a full route, route/type or route
+func ParseFullRoute(fullRoute string) (route, msgType string) {
+	parts := strings.SplitN(fullRoute, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// validate the route and msg type of a circuit
+func ValidateCircuit(route, msgType string) error {
+	if route == "" {
+		return fmt.Errorf("route can't be empty")
+	}
+	if strings.Contains(route, "/") {
+		return fmt.Errorf("route %s can't contain '/'", route)
+	}
+	// the circuit msgs can't be disabled, or the circuits could never be reset
+	if route == RouterKey {
+		return fmt.Errorf("the %s msgs can't be disabled", RouterKey)
+	}
+	return nil
+}
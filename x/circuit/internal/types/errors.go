@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// default codespace for circuit module
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidInput  sdk.CodeType = 101
+	CodeUnauthorized  sdk.CodeType = 102
+	CodeMsgDisabled   sdk.CodeType = 103
+	CodeCircuitNotSet sdk.CodeType = 104
+)
+
+// ErrNilAuthority - no authority provided for the msg
+func ErrNilAuthority(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "authority address is nil")
+}
+
+// ErrInvalidCircuit - invalid route or msg type
+func ErrInvalidCircuit(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid circuit: %s", err))
+}
+
+// ErrNotAuthority - the signer isn't a circuit authority
+func ErrNotAuthority(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, fmt.Sprintf("%s is not a circuit authority", addr))
+}
+
+// ErrMsgDisabled - the msg is disabled by the circuit breaker
+func ErrMsgDisabled(codespace sdk.CodespaceType, route, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeMsgDisabled, fmt.Sprintf("msg %s/%s is disabled by the circuit breaker", route, msgType))
+}
+
+// ErrCircuitNotTripped - there is no circuit to reset
+func ErrCircuitNotTripped(codespace sdk.CodespaceType, fullRoute string) sdk.Error {
+	return sdk.NewError(codespace, CodeCircuitNotSet, fmt.Sprintf("no circuit is tripped for %s", fullRoute))
+}
@@ -0,0 +1,12 @@
+package types
+
+// Circuit module event types
+var (
+	EventTypeTripCircuit  = "trip_circuit"
+	EventTypeResetCircuit = "reset_circuit"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyRoute      = "route"
+	AttributeKeyMsgType    = "msg_type"
+	AttributeKeyReason     = "reason"
+)
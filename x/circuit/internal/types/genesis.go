@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - circuit genesis state
+type GenesisState struct {
+	Params       Params        `json:"params" yaml:"params"`
+	DisabledMsgs []DisabledMsg `json:"disabled_msgs" yaml:"disabled_msgs"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, disabledMsgs []DisabledMsg) GenesisState {
+	return GenesisState{
+		Params:       params,
+		DisabledMsgs: disabledMsgs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:       DefaultParams(),
+		DisabledMsgs: []DisabledMsg{},
+	}
+}
+
+// ValidateGenesis - validate circuit genesis data
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(data.DisabledMsgs))
+	for _, disabled := range data.DisabledMsgs {
+		if err := ValidateCircuit(disabled.Route, disabled.Type); err != nil {
+			return err
+		}
+		if seen[disabled.FullRoute()] {
+			return fmt.Errorf("duplicate disabled msg %s in genesis state", disabled.FullRoute())
+		}
+		seen[disabled.FullRoute()] = true
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	// module name
+	ModuleName = "circuit"
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+	// RouterKey is the msg router key for the circuit module
+	RouterKey = ModuleName
+	// QuerierRoute is the querier route for the circuit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	DisabledMsgKey = []byte{0x01} // prefix for the disabled msg routes and types
+)
+
+// generates the key of a disabled msg route, or of a msg type of the route if msgType isn't empty
+// NOTE the key is of format prefix || route || '/' || type, and routes are alphanumeric
+func KeyForDisabledMsg(route, msgType string) []byte {
+	return append(DisabledMsgKey, []byte(route+"/"+msgType)...)
+}
+
+// extract the route and the msg type from a disabled msg key
+func RouteAndTypeFromDisabledMsgKey(key []byte) (route, msgType string) {
+	parts := strings.SplitN(string(key[len(DisabledMsgKey):]), "/", 2)
+	return parts[0], parts[1]
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgTripCircuit{}
+	_ sdk.Msg = &MsgResetCircuit{}
+)
+
+// MsgTripCircuit - message struct to disable a msg route, or a single msg type of the route
+type MsgTripCircuit struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgRoute  string         `json:"msg_route" yaml:"msg_route"`
+	MsgType   string         `json:"msg_type" yaml:"msg_type"` // empty to disable every msg of the route
+	Reason    string         `json:"reason" yaml:"reason"`
+}
+
+// NewMsgTripCircuit creates a new MsgTripCircuit object
+func NewMsgTripCircuit(authority sdk.AccAddress, msgRoute, msgType, reason string) MsgTripCircuit {
+	return MsgTripCircuit{
+		Authority: authority,
+		MsgRoute:  msgRoute,
+		MsgType:   msgType,
+		Reason:    reason,
+	}
+}
+
+// nolint
+func (msg MsgTripCircuit) Route() string { return RouterKey }
+func (msg MsgTripCircuit) Type() string  { return "trip_circuit" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTripCircuit) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Authority} }
+
+// GetSignBytes gets the sign bytes for the msg MsgTripCircuit
+func (msg MsgTripCircuit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTripCircuit) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return ErrNilAuthority(DefaultCodespace)
+	}
+	if err := ValidateCircuit(msg.MsgRoute, msg.MsgType); err != nil {
+		return ErrInvalidCircuit(DefaultCodespace, err)
+	}
+	return nil
+}
+
+// MsgResetCircuit - message struct to enable back a msg route, or a single msg type of the route
+type MsgResetCircuit struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgRoute  string         `json:"msg_route" yaml:"msg_route"`
+	MsgType   string         `json:"msg_type" yaml:"msg_type"` // empty for the circuit of the whole route
+}
+
+// NewMsgResetCircuit creates a new MsgResetCircuit object
+func NewMsgResetCircuit(authority sdk.AccAddress, msgRoute, msgType string) MsgResetCircuit {
+	return MsgResetCircuit{
+		Authority: authority,
+		MsgRoute:  msgRoute,
+		MsgType:   msgType,
+	}
+}
+
+// nolint
+func (msg MsgResetCircuit) Route() string { return RouterKey }
+func (msg MsgResetCircuit) Type() string  { return "reset_circuit" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgResetCircuit) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Authority} }
+
+// GetSignBytes gets the sign bytes for the msg MsgResetCircuit
+func (msg MsgResetCircuit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgResetCircuit) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return ErrNilAuthority(DefaultCodespace)
+	}
+	if err := ValidateCircuit(msg.MsgRoute, msg.MsgType); err != nil {
+		return ErrInvalidCircuit(DefaultCodespace, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// key for the circuit authorities parameter
+	KeyAuthorities = []byte("Authorities")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the high level settings for circuit module
+type Params struct {
+	Authorities []sdk.AccAddress `json:"authorities" yaml:"authorities"` // accounts allowed to trip and reset circuits
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAuthorities, &p.Authorities, validateAuthorities),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{Authorities: []sdk.AccAddress{}}
+}
+
+// returns true if addr is a circuit authority
+func (p Params) IsAuthority(addr sdk.AccAddress) bool {
+	for _, authority := range p.Authorities {
+		if bytes.Equal(authority, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	return validateAuthorities(p.Authorities)
+}
+
+func validateAuthorities(i interface{}) error {
+	authorities, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for n, authority := range authorities {
+		if authority.Empty() {
+			return fmt.Errorf("circuit authority %d is empty", n)
+		}
+	}
+	return nil
+}
+
+// String returns a human readable string representation of the parameters.
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Authorities: %v`, p.Authorities)
+}
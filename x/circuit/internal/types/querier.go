@@ -0,0 +1,7 @@
+package types
+
+// query endpoints supported by the circuit Querier
+const (
+	QueryDisabledMsgs = "disabled_msgs"
+	QueryParameters   = "parameters"
+)
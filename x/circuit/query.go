@@ -0,0 +1,37 @@
+package circuit
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+)
+
+func QueryDisabledMsgRoutes(clientCtx util.ClientContext, cdc *codec.Codec, height int64) ([]types.DisabledMsg, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDisabledMsgs)
+	bz, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+	var disabledMsgs []types.DisabledMsg
+	if err := cdc.UnmarshalJSON(bz, &disabledMsgs); err != nil {
+		return nil, err
+	}
+	return disabledMsgs, nil
+}
+
+func QueryCircuitParams(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (types.Params, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+	bz, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return types.Params{}, err
+	}
+	var params types.Params
+	if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+		return types.Params{}, err
+	}
+	return params, nil
+}
@@ -0,0 +1,21 @@
+package circuit
+
+import (
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/pokt-network/posmint/x/circuit/internal/types"
+)
+
+func TripCircuitTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.AccAddress, passphrase, msgRoute, msgType, reason string) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, address, passphrase).WithCodec(cdc)
+	msg := types.NewMsgTripCircuit(cliCtx.GetFromAddress(), msgRoute, msgType, reason)
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func ResetCircuitTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.AccAddress, passphrase, msgRoute, msgType string) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, address, passphrase).WithCodec(cdc)
+	msg := types.NewMsgResetCircuit(cliCtx.GetFromAddress(), msgRoute, msgType)
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
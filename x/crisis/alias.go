@@ -10,32 +10,54 @@ import (
 )
 
 const (
-	DefaultCodespace  = types.DefaultCodespace
-	CodeInvalidInput  = types.CodeInvalidInput
-	ModuleName        = types.ModuleName
-	DefaultParamspace = types.DefaultParamspace
+	DefaultCodespace      = types.DefaultCodespace
+	CodeInvalidInput      = types.CodeInvalidInput
+	CodeNoInvariantResult = types.CodeNoInvariantResult
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	QuerierRoute          = types.QuerierRoute
+	DefaultParamspace     = types.DefaultParamspace
+	ModeHalt              = types.ModeHalt
+	ModeLog               = types.ModeLog
+	ModeTrip              = types.ModeTrip
+	QueryInvariantResults = types.QueryInvariantResults
+	QueryInvariantResult  = types.QueryInvariantResult
+	QueryParameters       = types.QueryParameters
 )
 
 var (
 	// functions aliases
-	RegisterCodec         = types.RegisterCodec
-	ErrNilSender          = types.ErrNilSender
-	ErrUnknownInvariant   = types.ErrUnknownInvariant
-	NewGenesisState       = types.NewGenesisState
-	DefaultGenesisState   = types.DefaultGenesisState
-	NewMsgVerifyInvariant = types.NewMsgVerifyInvariant
-	ParamKeyTable         = types.ParamKeyTable
-	NewInvarRoute         = types.NewInvarRoute
-	NewKeeper             = keeper.NewKeeper
+	RegisterCodec                 = types.RegisterCodec
+	ErrNilSender                  = types.ErrNilSender
+	ErrUnknownInvariant           = types.ErrUnknownInvariant
+	ErrNoInvariantResult          = types.ErrNoInvariantResult
+	NewInvariantMode              = types.NewInvariantMode
+	ValidateInvariantModes        = types.ValidateInvariantModes
+	NewInvariantResult            = types.NewInvariantResult
+	NewQueryInvariantResultParams = types.NewQueryInvariantResultParams
+	NewGenesisState               = types.NewGenesisState
+	DefaultGenesisState           = types.DefaultGenesisState
+	NewMsgVerifyInvariant         = types.NewMsgVerifyInvariant
+	ParamKeyTable                 = types.ParamKeyTable
+	NewInvarRoute                 = types.NewInvarRoute
+	NewKeeper                     = keeper.NewKeeper
+	NewQuerier                    = keeper.NewQuerier
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeyConstantFee = types.ParamStoreKeyConstantFee
+	ModuleCdc                   = types.ModuleCdc
+	ParamStoreKeyConstantFee    = types.ParamStoreKeyConstantFee
+	ParamStoreKeyInvariantModes = types.ParamStoreKeyInvariantModes
+	InvariantResultKey          = types.InvariantResultKey
 )
 
 type (
-	GenesisState       = types.GenesisState
-	MsgVerifyInvariant = types.MsgVerifyInvariant
-	InvarRoute         = types.InvarRoute
-	Keeper             = keeper.Keeper
+	GenesisState               = types.GenesisState
+	MsgVerifyInvariant         = types.MsgVerifyInvariant
+	InvarRoute                 = types.InvarRoute
+	Mode                       = types.Mode
+	InvariantMode              = types.InvariantMode
+	InvariantResult            = types.InvariantResult
+	InvariantStatus            = types.InvariantStatus
+	QueryInvariantResultParams = types.QueryInvariantResultParams
+	Keeper                     = keeper.Keeper
 )
@@ -9,10 +9,12 @@ import (
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	keeper.SetInvariantModes(ctx, data.InvariantModes)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	constantFee := keeper.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	invariantModes := keeper.GetInvariantModes(ctx)
+	return types.NewGenesisState(constantFee, invariantModes)
 }
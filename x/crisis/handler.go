@@ -34,17 +34,15 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 		return err.Result()
 	}
 
-	// use a cached context to avoid gas costs during invariants
-	cacheCtx, _ := ctx.CacheContext()
-
 	found := false
 	msgFullRoute := msg.FullInvariantRoute()
 
 	var res string
-	var stop bool
-	for _, invarRoute := range k.Routes() {
-		if invarRoute.FullRoute() == msgFullRoute {
-			res, stop = invarRoute.Invar(cacheCtx)
+	var broken bool
+	var mode types.Mode
+	for _, ir := range k.Routes() {
+		if ir.FullRoute() == msgFullRoute {
+			res, broken, mode = k.CheckInvariant(ctx, ir)
 			found = true
 			break
 		}
@@ -54,24 +52,22 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 		return types.ErrUnknownInvariant(types.DefaultCodespace).Result()
 	}
 
-	if stop {
-		// NOTE currently, because the chain halts here, this transaction will never be included
-		// in the blockchain thus the constant fee will have never been deducted. Thus no
-		// refund is required.
-
-		// TODO uncomment the following code block with implementation of the circuit breaker
-		//// refund constant fee
-		//err := k.distrKeeper.DistributeFromFeePool(ctx, constantFee, msg.Sender)
-		//if err != nil {
-		//// if there are insufficient coins to refund, log the error,
-		//// but still halt the chain.
-		//logger := ctx.Logger().With("module", "x/crisis")
-		//logger.Error(fmt.Sprintf(
-		//"WARNING: insufficient funds to allocate to sender from fee pool, err: %s", err))
-		//}
-
-		// TODO replace with circuit breaker
-		panic(res)
+	if broken {
+		if mode == types.ModeHalt {
+			// NOTE currently, because the chain halts here, this transaction will never be included
+			// in the blockchain thus the constant fee will have never been deducted. Thus no
+			// refund is required.
+			panic(res)
+		}
+		// the invariant is logged or its circuits are tripped and the chain goes on,
+		// refund the constant fee to the reporter
+		if err := k.RefundFromFeeCollector(ctx, msg.Sender, constantFee); err != nil {
+			// if there are insufficient coins to refund, log the error,
+			// but still record the broken invariant
+			logger := k.Logger(ctx)
+			logger.Error(fmt.Sprintf(
+				"WARNING: insufficient funds to refund the constant fee to sender from fee collector, err: %s", err))
+		}
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/crisis/internal/types"
 	"github.com/pokt-network/posmint/x/params"
@@ -13,23 +16,30 @@ import (
 
 // Keeper - crisis keeper
 type Keeper struct {
+	storeKey       sdk.StoreKey
+	cdc            *codec.Codec
 	routes         []types.InvarRoute
 	paramSpace     params.Subspace
 	invCheckPeriod uint
+	durations      *durations // node local, never part of the state
 
-	supplyKeeper types.SupplyKeeper
+	supplyKeeper  types.SupplyKeeper
+	circuitKeeper types.CircuitKeeper // optional, nil to halt the chain on any broken invariant
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace params.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, invCheckPeriod uint,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string,
 ) Keeper {
 
 	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
+		durations:        &durations{m: make(map[string]time.Duration)},
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
@@ -48,6 +58,50 @@ func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	k.routes = append(k.routes, invarRoute)
 }
 
+// SetCircuitKeeper makes the keeper trip the circuits of the broken invariants
+// registered with RegisterRouteWithCircuits, instead of halting the chain
+func (k *Keeper) SetCircuitKeeper(circuitKeeper types.CircuitKeeper) {
+	k.circuitKeeper = circuitKeeper
+}
+
+// RegisterRouteWithCircuits registers an invariant whose breaking disables the
+// msg routes, or route/type, of circuits instead of halting the chain
+func (k *Keeper) RegisterRouteWithCircuits(moduleName, route string, invar sdk.Invariant, circuits ...string) {
+	invarRoute := types.NewInvarRoute(moduleName, route, invar)
+	invarRoute.Circuits = circuits
+	k.routes = append(k.routes, invarRoute)
+}
+
+// TripCircuits disables the circuits of a broken invariant, and returns false if
+// the invariant has no circuit to trip, in which case the chain must halt
+func (k Keeper) TripCircuits(ctx sdk.Context, invarRoute types.InvarRoute, res string) bool {
+	if k.circuitKeeper == nil || len(invarRoute.Circuits) == 0 {
+		return false
+	}
+	for _, circuit := range invarRoute.Circuits {
+		route, msgType := circuitRouteAndType(circuit)
+		if k.circuitKeeper.IsMsgDisabled(ctx, route, msgType) {
+			continue
+		}
+		reason := fmt.Sprintf("invariant %s broken: %s", invarRoute.FullRoute(), res)
+		if err := k.circuitKeeper.TripCircuit(ctx, nil, route, msgType, reason); err != nil {
+			// a circuit that can't be tripped can't protect the chain
+			panic(err)
+		}
+	}
+	k.Logger(ctx).Error(fmt.Sprintf("invariant %s broken, circuits %v tripped: %s", invarRoute.FullRoute(), invarRoute.Circuits, res))
+	return true
+}
+
+// returns the route and msg type of a circuit, route/type or route
+func circuitRouteAndType(circuit string) (route, msgType string) {
+	parts := strings.SplitN(circuit, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
 // Routes - return the keeper's invariant routes
 func (k Keeper) Routes() []types.InvarRoute {
 	return k.routes
@@ -62,8 +116,50 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
+// InvariantMode returns the mode of an invariant route. Routes without a mode
+// in the params trip their circuits if they have any, and halt otherwise.
+func (k Keeper) InvariantMode(ctx sdk.Context, invarRoute types.InvarRoute) types.Mode {
+	for _, im := range k.GetInvariantModes(ctx) {
+		if im.Route == invarRoute.FullRoute() {
+			return im.Mode
+		}
+	}
+	if k.circuitKeeper != nil && len(invarRoute.Circuits) != 0 {
+		return types.ModeTrip
+	}
+	return types.ModeHalt
+}
+
+// CheckInvariant asserts a single invariant, records its result and duration, and
+// handles a broken invariant according to its mode. The returned mode is the one
+// applied, the caller must halt the chain if it is ModeHalt and the invariant is broken.
+func (k Keeper) CheckInvariant(ctx sdk.Context, invarRoute types.InvarRoute) (res string, broken bool, mode types.Mode) {
+	// use a cached context, the invariants must not write to the state
+	cacheCtx, _ := ctx.CacheContext()
+
+	start := time.Now()
+	res, broken = invarRoute.Invar(cacheCtx)
+	duration := time.Since(start)
+	k.durations.set(invarRoute.FullRoute(), duration)
+
+	mode = k.InvariantMode(ctx, invarRoute)
+	if broken {
+		switch mode {
+		case types.ModeLog:
+			k.Logger(ctx).Error(fmt.Sprintf("invariant %s broken: %s", invarRoute.FullRoute(), res))
+		case types.ModeTrip:
+			if !k.TripCircuits(ctx, invarRoute, res) {
+				mode = types.ModeHalt
+			}
+		}
+	}
+	k.Logger(ctx).Debug("asserted invariant", "route", invarRoute.FullRoute(), "duration", duration, "broken", broken)
+	k.SetInvariantResult(ctx, types.NewInvariantResult(invarRoute.FullRoute(), ctx.BlockHeight(), broken, res, mode))
+	return res, broken, mode
+}
+
 // AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// the method panics, unless the mode of the invariant is to log it or to trip its circuits.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -71,7 +167,7 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	invarRoutes := k.Routes()
 
 	for _, ir := range invarRoutes {
-		if res, stop := ir.Invar(ctx); stop {
+		if res, broken, mode := k.CheckInvariant(ctx, ir); broken && mode == types.ModeHalt {
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -86,10 +182,73 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// GetInvariantResult returns the last result of an invariant, by its full route
+func (k Keeper) GetInvariantResult(ctx sdk.Context, fullRoute string) (result types.InvariantResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForInvariantResult(fullRoute))
+	if value == nil {
+		return result, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &result)
+	return result, true
+}
+
+// SetInvariantResult stores the last result of an invariant
+func (k Keeper) SetInvariantResult(ctx sdk.Context, result types.InvariantResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(result)
+	store.Set(types.KeyForInvariantResult(result.Route), bz)
+}
+
+// GetAllInvariantResults returns the last result of every invariant asserted
+func (k Keeper) GetAllInvariantResults(ctx sdk.Context) (results []types.InvariantResult) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InvariantResultKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.InvariantResult
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// InvariantStatus returns the last result of an invariant with the time this node
+// last took to assert it, zero if it hasn't since it started
+func (k Keeper) InvariantStatus(result types.InvariantResult) types.InvariantStatus {
+	return types.InvariantStatus{
+		Result:   result,
+		Duration: k.durations.get(result.Route),
+	}
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
+// RefundFromFeeCollector transfers amt from the fee collector account back to recipientAddr.
+func (k Keeper) RefundFromFeeCollector(ctx sdk.Context, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, recipientAddr, amt)
+}
+
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account.
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
 }
+
+// the last duration of each invariant route, shared by the copies of the keeper
+type durations struct {
+	mtx sync.RWMutex
+	m   map[string]time.Duration
+}
+
+func (d *durations) set(fullRoute string, duration time.Duration) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.m[fullRoute] = duration
+}
+
+func (d *durations) get(fullRoute string) time.Duration {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	return d.m[fullRoute]
+}
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/store"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/crisis/internal/types"
 	"github.com/pokt-network/posmint/x/params"
@@ -20,24 +23,30 @@ func testFailingInvariant(_ sdk.Context) (string, bool) {
 	return "", true
 }
 
-func testKeeper(checkPeriod uint) Keeper {
-	cdc := codec.New()
-	paramsKeeper := params.NewKeeper(
-		cdc, sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey), params.DefaultCodespace,
-	)
+func testKeeper(t *testing.T, checkPeriod uint) (sdk.Context, Keeper) {
+	keyCrisis := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCrisis, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{Height: 5}, false, log.NewNopLogger())
 
-	return NewKeeper(paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	return ctx, NewKeeper(cdc, keyCrisis, paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
 }
 
 func TestLogger(t *testing.T) {
-	k := testKeeper(5)
-
-	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	ctx, k := testKeeper(t, 5)
 	require.Equal(t, ctx.Logger(), k.Logger(ctx))
 }
 
 func TestInvariants(t *testing.T) {
-	k := testKeeper(5)
+	_, k := testKeeper(t, 5)
 	require.Equal(t, k.InvCheckPeriod(), uint(5))
 
 	k.RegisterRoute("testModule", "testRoute", testPassingInvariant)
@@ -45,8 +54,7 @@ func TestInvariants(t *testing.T) {
 }
 
 func TestAssertInvariants(t *testing.T) {
-	k := testKeeper(5)
-	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	ctx, k := testKeeper(t, 5)
 
 	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
 	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
@@ -54,3 +62,100 @@ func TestAssertInvariants(t *testing.T) {
 	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
 	require.Panics(t, func() { k.AssertInvariants(ctx) })
 }
+
+// records the tripped circuits
+type mockCircuitKeeper struct {
+	tripped map[string]bool
+}
+
+func (ck mockCircuitKeeper) IsMsgDisabled(_ sdk.Context, route, msgType string) bool {
+	return ck.tripped[route+"/"+msgType]
+}
+
+func (ck mockCircuitKeeper) TripCircuit(_ sdk.Context, _ sdk.AccAddress, route, msgType, _ string) sdk.Error {
+	ck.tripped[route+"/"+msgType] = true
+	return nil
+}
+
+func TestAssertInvariantsWithCircuits(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+
+	// without a circuit keeper the circuits are ignored
+	k.RegisterRouteWithCircuits("testModule", "testRoute1", testFailingInvariant, "bank/send")
+	require.Panics(t, func() { k.AssertInvariants(ctx) })
+
+	ck := mockCircuitKeeper{tripped: make(map[string]bool)}
+	k.SetCircuitKeeper(ck)
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+	require.True(t, ck.tripped["bank/send"])
+
+	k.RegisterRouteWithCircuits("testModule", "testRoute2", testFailingInvariant, "pos")
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+	require.True(t, ck.tripped["pos/"])
+
+	// an invariant without circuits still halts the chain
+	k.RegisterRoute("testModule", "testRoute3", testFailingInvariant)
+	require.Panics(t, func() { k.AssertInvariants(ctx) })
+}
+
+func TestInvariantModes(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
+	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
+	require.Panics(t, func() { k.AssertInvariants(ctx) })
+
+	// log the broken invariant and continue
+	k.SetInvariantModes(ctx, []types.InvariantMode{types.NewInvariantMode("testModule/testRoute2", types.ModeLog)})
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+	result, found := k.GetInvariantResult(ctx, "testModule/testRoute2")
+	require.True(t, found)
+	require.True(t, result.Broken)
+	require.Equal(t, int64(5), result.Height)
+	require.Equal(t, types.ModeLog, result.Mode)
+	result, found = k.GetInvariantResult(ctx, "testModule/testRoute1")
+	require.True(t, found)
+	require.False(t, result.Broken)
+	require.Len(t, k.GetAllInvariantResults(ctx), 2)
+
+	// trip without circuits halts the chain
+	k.SetInvariantModes(ctx, []types.InvariantMode{types.NewInvariantMode("testModule/testRoute2", types.ModeTrip)})
+	require.Panics(t, func() { k.AssertInvariants(ctx) })
+
+	// a halting invariant doesn't halt while it holds
+	k.SetInvariantModes(ctx, []types.InvariantMode{
+		types.NewInvariantMode("testModule/testRoute1", types.ModeHalt),
+		types.NewInvariantMode("testModule/testRoute2", types.ModeLog),
+	})
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+}
+
+func TestQuerier(t *testing.T) {
+	ctx, k := testKeeper(t, 5)
+	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
+	querier := NewQuerier(k)
+
+	bz, err := querier(ctx, []string{types.QueryInvariantResults}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var statuses []types.InvariantStatus
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &statuses))
+	require.Empty(t, statuses)
+
+	data := types.ModuleCdc.MustMarshalJSON(types.NewQueryInvariantResultParams("testModule/testRoute1"))
+	_, err = querier(ctx, []string{types.QueryInvariantResult}, abci.RequestQuery{Data: data})
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoInvariantResult, err.Code())
+
+	k.AssertInvariants(ctx)
+	bz, err = querier(ctx, []string{types.QueryInvariantResult}, abci.RequestQuery{Data: data})
+	require.Nil(t, err)
+	var status types.InvariantStatus
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &status))
+	require.Equal(t, "testModule/testRoute1", status.Result.Route)
+	require.Equal(t, int64(5), status.Result.Height)
+	require.False(t, status.Result.Broken)
+
+	bz, err = querier(ctx, []string{types.QueryInvariantResults}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &statuses))
+	require.Len(t, statuses, 1)
+}
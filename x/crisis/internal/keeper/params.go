@@ -15,3 +15,14 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetInvariantModes get's the modes of the invariant routes from the paramSpace
+func (k Keeper) GetInvariantModes(ctx sdk.Context) (invariantModes []types.InvariantMode) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyInvariantModes, &invariantModes)
+	return
+}
+
+// SetInvariantModes set's the modes of the invariant routes in the paramSpace
+func (k Keeper) SetInvariantModes(ctx sdk.Context, invariantModes []types.InvariantMode) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantModes, invariantModes)
+}
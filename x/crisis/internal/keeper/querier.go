@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/crisis/internal/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// creates a querier for crisis REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case types.QueryInvariantResults:
+			return queryInvariantResults(ctx, k)
+		case types.QueryInvariantResult:
+			return queryInvariantResult(ctx, req, k)
+		case types.QueryParameters:
+			return queryParameters(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown crisis query endpoint")
+		}
+	}
+}
+
+func queryInvariantResults(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	statuses := make([]types.InvariantStatus, 0)
+	for _, result := range k.GetAllInvariantResults(ctx) {
+		statuses = append(statuses, k.InvariantStatus(result))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, statuses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryInvariantResult(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryInvariantResultParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	result, found := k.GetInvariantResult(ctx, params.Route)
+	if !found {
+		return nil, types.ErrNoInvariantResult(types.DefaultCodespace, params.Route)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.InvariantStatus(result))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := types.NewGenesisState(k.GetConstantFee(ctx), k.GetInvariantModes(ctx))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not marshal %s params to JSON", types.ModuleName), err.Error()))
+	}
+
+	return res, nil
+}
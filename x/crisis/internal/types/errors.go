@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -10,6 +12,9 @@ const (
 
 	// CodeInvalidInput is the codetype for invalid input for the crisis module
 	CodeInvalidInput sdk.CodeType = 103
+
+	// CodeNoInvariantResult is the codetype for an invariant that has never been asserted
+	CodeNoInvariantResult sdk.CodeType = 104
 )
 
 // ErrNilSender -  no sender provided for the input
@@ -21,3 +26,8 @@ func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
 func ErrUnknownInvariant(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unknown invariant")
 }
+
+// ErrNoInvariantResult - the invariant has never been asserted
+func ErrNoInvariantResult(codespace sdk.CodespaceType, fullRoute string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoInvariantResult, fmt.Sprintf("no result for invariant %s", fullRoute))
+}
@@ -7,4 +7,11 @@ import (
 // SupplyKeeper defines the expected supply keeper (noalias)
 type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// CircuitKeeper defines the expected circuit keeper, tripping circuits instead of halting the chain (noalias)
+type CircuitKeeper interface {
+	IsMsgDisabled(ctx sdk.Context, route, msgType string) bool
+	TripCircuit(ctx sdk.Context, authority sdk.AccAddress, route, msgType, reason string) sdk.Error
 }
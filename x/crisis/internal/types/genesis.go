@@ -8,20 +8,23 @@ import (
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	ConstantFee    sdk.Coin        `json:"constant_fee" yaml:"constant_fee"`
+	InvariantModes []InvariantMode `json:"invariant_modes" yaml:"invariant_modes"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) GenesisState {
+func NewGenesisState(constantFee sdk.Coin, invariantModes []InvariantMode) GenesisState {
 	return GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:    constantFee,
+		InvariantModes: invariantModes,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		InvariantModes: []InvariantMode{},
 	}
 }
 
@@ -30,5 +33,5 @@ func ValidateGenesis(data GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
-	return nil
+	return ValidateInvariantModes(data.InvariantModes)
 }
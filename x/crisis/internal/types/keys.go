@@ -3,4 +3,19 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the crisis module
+	QuerierRoute = ModuleName
 )
+
+var (
+	InvariantResultKey = []byte{0x01} // prefix for the last result of each invariant route
+)
+
+// generates the key for the last result of an invariant, by its full route
+func KeyForInvariantResult(fullRoute string) []byte {
+	return append(InvariantResultKey, []byte(fullRoute)...)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Mode - what the chain does when an invariant is broken
+type Mode string
+
+const (
+	ModeHalt Mode = "halt" // panic, halting the chain
+	ModeLog  Mode = "log"  // log the broken invariant and continue
+	ModeTrip Mode = "trip" // trip the circuits of the invariant, halting if it has none
+)
+
+// validate the mode
+func (m Mode) Validate() error {
+	switch m {
+	case ModeHalt, ModeLog, ModeTrip:
+		return nil
+	default:
+		return fmt.Errorf("invalid invariant mode %q, must be one of %s, %s or %s", m, ModeHalt, ModeLog, ModeTrip)
+	}
+}
+
+// InvariantMode - the mode of an invariant, by its full route module/route
+type InvariantMode struct {
+	Route string `json:"route" yaml:"route"`
+	Mode  Mode   `json:"mode" yaml:"mode"`
+}
+
+// NewInvariantMode creates a new InvariantMode object
+func NewInvariantMode(fullRoute string, mode Mode) InvariantMode {
+	return InvariantMode{
+		Route: fullRoute,
+		Mode:  mode,
+	}
+}
+
+// validate a list of invariant modes, each route set at most once
+func ValidateInvariantModes(modes []InvariantMode) error {
+	routes := make(map[string]bool, len(modes))
+	for _, im := range modes {
+		if im.Route == "" {
+			return fmt.Errorf("invariant mode with an empty route")
+		}
+		if routes[im.Route] {
+			return fmt.Errorf("duplicate mode for invariant %s", im.Route)
+		}
+		routes[im.Route] = true
+		if err := im.Mode.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
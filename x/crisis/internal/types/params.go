@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/params"
 )
@@ -13,11 +15,32 @@ const (
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// key for the invariant modes parameter
+	ParamStoreKeyInvariantModes = []byte("InvariantModes")
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyConstantFee, sdk.Coin{},
-	)
+	return params.NewKeyTable().
+		RegisterTypeWithValidator(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee).
+		RegisterTypeWithValidator(ParamStoreKeyInvariantModes, []InvariantMode{}, validateInvariantModes)
+}
+
+func validateConstantFee(i interface{}) error {
+	constantFee, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !constantFee.IsValid() || !constantFee.IsPositive() {
+		return fmt.Errorf("constant fee must be positive: %s", constantFee)
+	}
+	return nil
+}
+
+func validateInvariantModes(i interface{}) error {
+	modes, ok := i.([]InvariantMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateInvariantModes(modes)
 }
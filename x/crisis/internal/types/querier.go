@@ -0,0 +1,20 @@
+package types
+
+// query endpoints supported by the crisis Querier
+const (
+	QueryInvariantResults = "invariant_results"
+	QueryInvariantResult  = "invariant_result"
+	QueryParameters       = "parameters"
+)
+
+// QueryInvariantResultParams - the full route of the invariant to query
+type QueryInvariantResultParams struct {
+	Route string `json:"route"`
+}
+
+// NewQueryInvariantResultParams creates a new QueryInvariantResultParams object
+func NewQueryInvariantResultParams(fullRoute string) QueryInvariantResultParams {
+	return QueryInvariantResultParams{
+		Route: fullRoute,
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// InvariantResult - the last result of an invariant route
+type InvariantResult struct {
+	Route   string `json:"route" yaml:"route"`     // full route of the invariant
+	Height  int64  `json:"height" yaml:"height"`   // height the invariant was last asserted at
+	Broken  bool   `json:"broken" yaml:"broken"`   // true if the invariant was broken
+	Message string `json:"message" yaml:"message"` // message returned by the invariant
+	Mode    Mode   `json:"mode" yaml:"mode"`       // mode applied to the result
+}
+
+// NewInvariantResult creates a new InvariantResult object
+func NewInvariantResult(fullRoute string, height int64, broken bool, msg string, mode Mode) InvariantResult {
+	return InvariantResult{
+		Route:   fullRoute,
+		Height:  height,
+		Broken:  broken,
+		Message: msg,
+		Mode:    mode,
+	}
+}
+
+// String returns a human readable string representation of the result.
+func (r InvariantResult) String() string {
+	return fmt.Sprintf(`InvariantResult:
+  Route:   %s
+  Height:  %d
+  Broken:  %v
+  Message: %s
+  Mode:    %s`, r.Route, r.Height, r.Broken, r.Message, r.Mode)
+}
+
+// InvariantStatus - the last result of an invariant with the time this node took to assert it.
+// The duration is local to the node, never part of the state.
+type InvariantStatus struct {
+	Result   InvariantResult `json:"result" yaml:"result"`
+	Duration time.Duration   `json:"duration" yaml:"duration"`
+}
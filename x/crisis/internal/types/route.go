@@ -9,6 +9,7 @@ type InvarRoute struct {
 	ModuleName string
 	Route      string
 	Invar      sdk.Invariant
+	Circuits   []string // msg routes, or route/type, disabled instead of halting the chain when broken
 }
 
 // NewInvarRoute - create an InvarRoute object
@@ -2,8 +2,6 @@ package crisis
 
 import (
 	"encoding/json"
-	"github.com/pokt-network/posmint/crypto/keys"
-	"github.com/tendermint/tendermint/node"
 
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
@@ -52,18 +50,14 @@ type AppModule struct {
 	// NOTE: We store a reference to the keeper here so that after a module
 	// manager is created, the invariants can be properly registered and
 	// executed.
-	keeper  *keeper.Keeper
-	node    *node.Node
-	keybase keys.Keybase
+	keeper *keeper.Keeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper *keeper.Keeper, node *node.Node, keybase keys.Keybase) AppModule {
+func NewAppModule(keeper *keeper.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
-		node:           node,
-		keybase:        keybase,
 	}
 }
 
@@ -85,20 +79,14 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(*am.keeper)
 }
 
-func (am AppModule) GetTendermintNode() *node.Node {
-	return am.node
-}
+// QuerierRoute returns the crisis module's querier route name.
+func (AppModule) QuerierRoute() string { return QuerierRoute }
 
-func (am AppModule) GetKeybase() keys.Keybase {
-	return am.keybase
+// NewQuerierHandler returns the crisis module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(*am.keeper)
 }
 
-// QuerierRoute returns no querier route.
-func (AppModule) QuerierRoute() string { return "" }
-
-// NewQuerierHandler returns no sdk.Querier.
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
-
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -117,6 +105,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
+// ConsensusVersion returns the version of the crisis module state.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock returns the begin blocker for the crisis module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
@@ -0,0 +1,56 @@
+package crisis
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/pokt-network/posmint/x/crisis/internal/types"
+)
+
+func QueryInvariantStatuses(clientCtx util.ClientContext, cdc *codec.Codec, height int64) ([]types.InvariantStatus, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResults)
+	bz, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []types.InvariantStatus
+	if err := cdc.UnmarshalJSON(bz, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+func QueryInvariantStatus(clientCtx util.ClientContext, cdc *codec.Codec, moduleName, route string, height int64) (types.InvariantStatus, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	params := types.NewQueryInvariantResultParams(moduleName + "/" + route)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return types.InvariantStatus{}, err
+	}
+	queryRoute := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResult)
+	res, _, err := cliCtx.QueryWithData(queryRoute, bz)
+	if err != nil {
+		return types.InvariantStatus{}, err
+	}
+	var status types.InvariantStatus
+	if err := cdc.UnmarshalJSON(res, &status); err != nil {
+		return types.InvariantStatus{}, err
+	}
+	return status, nil
+}
+
+func QueryCrisisParams(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (types.GenesisState, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+	bz, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return types.GenesisState{}, err
+	}
+	var params types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+		return types.GenesisState{}, err
+	}
+	return params, nil
+}
@@ -8,8 +8,8 @@ import (
 	"github.com/pokt-network/posmint/x/crisis/internal/types"
 )
 
-func (am AppModule) InvariantBroken(cdc *codec.Codec, moduleName, route string, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
-	cliCtx := util.NewCLIContext(am.node, sdk.AccAddress(address), passphrase).WithCodec(cdc)
+func InvariantBroken(clientCtx util.ClientContext, cdc *codec.Codec, moduleName, route string, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(address), passphrase).WithCodec(cdc)
 	senderAddr := cliCtx.GetFromAddress()
 	msg := types.NewMsgVerifyInvariant(senderAddr, moduleName, route)
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
@@ -0,0 +1,10 @@
+package params
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// apply the param changes scheduled for this block
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.ApplyScheduledParamChanges(ctx)
+}
@@ -11,39 +11,67 @@ import (
 )
 
 const (
-	StoreKey             = subspace.StoreKey
-	TStoreKey            = subspace.TStoreKey
-	TestParamStore       = subspace.TestParamStore
-	DefaultCodespace     = types.DefaultCodespace
-	CodeUnknownSubspace  = types.CodeUnknownSubspace
-	CodeSettingParameter = types.CodeSettingParameter
-	CodeEmptyData        = types.CodeEmptyData
-	ModuleName           = types.ModuleName
-	RouterKey            = types.RouterKey
+	StoreKey              = subspace.StoreKey
+	TStoreKey             = subspace.TStoreKey
+	TestParamStore        = subspace.TestParamStore
+	DefaultCodespace      = types.DefaultCodespace
+	CodeUnknownSubspace   = types.CodeUnknownSubspace
+	CodeSettingParameter  = types.CodeSettingParameter
+	CodeEmptyData         = types.CodeEmptyData
+	CodeUnknownParam      = types.CodeUnknownParam
+	ModuleName            = types.ModuleName
+	RouterKey             = types.RouterKey
+	QuerierRoute          = types.QuerierRoute
+	QueryParam            = types.QueryParam
+	QuerySubspace         = types.QuerySubspace
+	QueryScheduledChanges = types.QueryScheduledChanges
+	QueryParamHistory     = types.QueryParamHistory
+	CodeInvalidSchedule   = types.CodeInvalidSchedule
+	SourceSet             = types.SourceSet
+	SourceUpdate          = types.SourceUpdate
+	SourceScheduled       = types.SourceScheduled
 )
 
 var (
 	// functions aliases
-	NewSubspace           = subspace.NewSubspace
-	NewKeyTable           = subspace.NewKeyTable
-	DefaultTestComponents = subspace.DefaultTestComponents
-	RegisterCodec         = types.RegisterCodec
-	ErrUnknownSubspace    = types.ErrUnknownSubspace
-	ErrSettingParameter   = types.ErrSettingParameter
-	ErrEmptyChanges       = types.ErrEmptyChanges
-	ErrEmptySubspace      = types.ErrEmptySubspace
-	ErrEmptyKey           = types.ErrEmptyKey
-	ErrEmptyValue         = types.ErrEmptyValue
+	NewSubspace              = subspace.NewSubspace
+	NewKeyTable              = subspace.NewKeyTable
+	NewParamSetPair          = subspace.NewParamSetPair
+	DefaultTestComponents    = subspace.DefaultTestComponents
+	RegisterCodec            = types.RegisterCodec
+	ErrUnknownSubspace       = types.ErrUnknownSubspace
+	ErrUnknownParam          = types.ErrUnknownParam
+	NewQueryParamParams      = types.NewQueryParamParams
+	ErrInvalidScheduleHeight = types.ErrInvalidScheduleHeight
+	ErrNoScheduledChange     = types.ErrNoScheduledChange
+	NewScheduledParamChange  = types.NewScheduledParamChange
+	KeyForScheduledChange    = types.KeyForScheduledChange
+	KeyForChangeHistory      = types.KeyForChangeHistory
+	ErrSettingParameter      = types.ErrSettingParameter
+	ErrEmptyChanges          = types.ErrEmptyChanges
+	ErrEmptySubspace         = types.ErrEmptySubspace
+	ErrEmptyKey              = types.ErrEmptyKey
+	ErrEmptyValue            = types.ErrEmptyValue
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	ValidateGenesis          = types.ValidateGenesis
 
 	// variable aliases
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc          = types.ModuleCdc
+	ScheduledChangeKey = types.ScheduledChangeKey
+	ChangeHistoryKey   = types.ChangeHistoryKey
 )
 
 type (
-	ParamSetPair     = subspace.ParamSetPair
-	ParamSetPairs    = subspace.ParamSetPairs
-	ParamSet         = subspace.ParamSet
-	Subspace         = subspace.Subspace
-	ReadOnlySubspace = subspace.ReadOnlySubspace
-	KeyTable         = subspace.KeyTable
+	ParamSetPair         = subspace.ParamSetPair
+	ValueValidatorFn     = subspace.ValueValidatorFn
+	ParamSetPairs        = subspace.ParamSetPairs
+	ParamSet             = subspace.ParamSet
+	Subspace             = subspace.Subspace
+	ReadOnlySubspace     = subspace.ReadOnlySubspace
+	KeyTable             = subspace.KeyTable
+	QueryParamParams     = types.QueryParamParams
+	ScheduledParamChange = types.ScheduledParamChange
+	ParamChangeRecord    = types.ParamChangeRecord
+	GenesisState         = types.GenesisState
 )
@@ -84,14 +84,26 @@ be able to be passed to SetParamSet.
 	}
 
 	// Implements params.ParamSet
-	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
-	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
-		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+	// ParamSetPairs must return the list of (ParamKey, PointerToTheField, Validator)
+	func (p *MyParams) ParamSetPairs() params.ParamSetPairs {
+		return params.ParamSetPairs{
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, nil),
 		}
 	}
 
+	// the validator receives the value, never a pointer to it
+	func validateParameter1(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v == 0 {
+			return fmt.Errorf("parameter1 must be positive")
+		}
+		return nil
+	}
+
 	func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.ps.SetParamSet(ctx, &data.params)
 	}
@@ -99,6 +111,28 @@ be able to be passed to SetParamSet.
 The method is pointer receiver because there could be a case that we read from
 the store and set the result to the struct.
 
+The validator of a key runs on every Set and Update of the key, so an invalid
+value is never stored: Set panics and Update returns the error.
+
+Querier Usage:
+
+The params querier, registered under QuerierRoute, returns the JSON of a single
+parameter with QueryParam, or of every parameter of a subspace with QuerySubspace.
+
+	app.QueryRouter().AddRoute(params.QuerierRoute, params.NewQuerier(app.paramsKeeper))
+
+Scheduled Changes and History:
+
+A change can be scheduled at a future height with the JSON Update would take. The
+params AppModule applies it in BeginBlock, dropping it if it became invalid.
+
+	change := params.NewScheduledParamChange(height, "pos", "MaxValidators", `"200"`, "upgrade-2")
+	err := app.paramsKeeper.ScheduleParamChange(ctx, change)
+
+Every Set and Update is recorded in the history of the param, with its height and
+source, so the value a param had at any height can be read with GetParamAtHeight.
+The pending changes and the history are exported and restored by the params genesis.
+
 Master Keeper Usage:
 
 Keepers that require master permission to the paramstore, such as gov, can take
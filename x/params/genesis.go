@@ -0,0 +1,33 @@
+package params
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/params/types"
+)
+
+// InitGenesis restores the scheduled changes and the change history. The changes are not
+// validated against the params, which the modules owning them may not have initialized yet;
+// they are validated when applied.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	store := ctx.KVStore(keeper.key)
+	for _, change := range data.ScheduledChanges {
+		store.Set(types.KeyForScheduledChange(change.Height, change.Subspace, change.Key), keeper.cdc.MustMarshalBinaryLengthPrefixed(change))
+	}
+	for _, record := range data.ChangeHistory {
+		store.Set(types.KeyForChangeHistory(record.Subspace, record.Key, record.Height), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+	}
+}
+
+// ExportGenesis returns the scheduled changes and the change history
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	changes := keeper.GetScheduledParamChanges(ctx)
+	if changes == nil {
+		changes = []types.ScheduledParamChange{}
+	}
+	history := []types.ParamChangeRecord{}
+	keeper.IterateAllParamHistory(ctx, func(record types.ParamChangeRecord) bool {
+		history = append(history, record)
+		return false
+	})
+	return types.NewGenesisState(changes, history)
+}
@@ -1,10 +1,13 @@
 package params
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/pokt-network/posmint/store/prefix"
 	sdk "github.com/pokt-network/posmint/types"
@@ -210,3 +213,75 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+type validatedParams struct {
+	Positive int64
+	Any      string
+}
+
+func (p *validatedParams) ParamSetPairs() ParamSetPairs {
+	return ParamSetPairs{
+		NewParamSetPair([]byte("positive"), &p.Positive, func(i interface{}) error {
+			if i.(int64) <= 0 {
+				return fmt.Errorf("must be positive")
+			}
+			return nil
+		}),
+		NewParamSetPair([]byte("any"), &p.Any, nil),
+	}
+}
+
+func TestValidation(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+
+	require.NotPanics(t, func() { space.SetParamSet(ctx, &validatedParams{Positive: 1}) })
+	require.Panics(t, func() { space.SetParamSet(ctx, &validatedParams{Positive: -1}) })
+	require.Panics(t, func() { space.Set(ctx, []byte("positive"), int64(0)) })
+	require.NotPanics(t, func() { space.Set(ctx, []byte("any"), "") })
+
+	require.Error(t, space.Update(ctx, []byte("positive"), []byte(`"-5"`)))
+	var param int64
+	space.Get(ctx, []byte("positive"), &param)
+	require.Equal(t, int64(1), param, "an invalid update must not be stored")
+	require.NoError(t, space.Update(ctx, []byte("positive"), []byte(`"5"`)))
+	space.Get(ctx, []byte("positive"), &param)
+	require.Equal(t, int64(5), param)
+
+	require.NoError(t, space.Validate([]byte("positive"), int64(1)))
+	require.Error(t, space.Validate([]byte("positive"), int64(0)))
+	require.Error(t, space.Validate([]byte("unknown"), int64(0)))
+}
+
+func TestQuerier(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	space.SetParamSet(ctx, &validatedParams{Positive: 7, Any: "value"})
+	querier := NewQuerier(keeper)
+
+	data := ModuleCdc.MustMarshalJSON(NewQueryParamParams("test", "positive"))
+	bz, err := querier(ctx, []string{QueryParam}, abci.RequestQuery{Data: data})
+	require.Nil(t, err)
+	require.Equal(t, `"7"`, string(bz))
+
+	data = ModuleCdc.MustMarshalJSON(NewQueryParamParams("test", "missing"))
+	_, err = querier(ctx, []string{QueryParam}, abci.RequestQuery{Data: data})
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownParam, err.Code())
+
+	data = ModuleCdc.MustMarshalJSON(NewQueryParamParams("unknown", "positive"))
+	_, err = querier(ctx, []string{QueryParam}, abci.RequestQuery{Data: data})
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownSubspace, err.Code())
+
+	data = ModuleCdc.MustMarshalJSON(NewQueryParamParams("test", ""))
+	bz, err = querier(ctx, []string{QuerySubspace}, abci.RequestQuery{Data: data})
+	require.Nil(t, err)
+	var values map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &values))
+	require.Len(t, values, 2)
+	require.Equal(t, `"value"`, string(values["any"]))
+	require.Equal(t, `"7"`, string(values["positive"]))
+}
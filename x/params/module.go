@@ -3,13 +3,17 @@ package params
 import (
 	"encoding/json"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/types/module"
 	"github.com/pokt-network/posmint/x/params/types"
 )
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule      = AppModule{}
 )
 
 const moduleName = "params"
@@ -28,7 +32,76 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 }
 
 // default genesis state
-func (AppModuleBasic) DefaultGenesis() json.RawMessage { return nil }
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
 
 // module validate genesis
-func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error { return nil }
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data types.GenesisState
+	err := types.ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return types.ValidateGenesis(data)
+}
+
+// AppModule implements an application module for the params module, applying the
+// scheduled param changes and serving the params querier.
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns no message route, the params are changed by the other modules.
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns no sdk.Handler.
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the params module's querier route name.
+func (AppModule) QuerierRoute() string { return types.QuerierRoute }
+
+// NewQuerierHandler returns the params module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// InitGenesis restores the scheduled param changes and the change history,
+// the params themselves are initialized by the modules owning them.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the scheduled param changes and the change history.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
+}
+
+// ConsensusVersion returns the version of the params module state.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// BeginBlock applies the param changes scheduled for this block.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
+// EndBlock returns no validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
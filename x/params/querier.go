@@ -0,0 +1,107 @@
+package params
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/params/types"
+)
+
+// NewQuerier creates a querier returning the JSON of any parameter, or of a whole subspace
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParam:
+			return queryParam(ctx, req, k)
+		case types.QuerySubspace:
+			return querySubspace(ctx, req, k)
+		case types.QueryScheduledChanges:
+			return queryScheduledChanges(ctx, k)
+		case types.QueryParamHistory:
+			return queryParamHistory(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
+		}
+	}
+}
+
+func queryParam(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	space, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, types.ErrUnknownSubspace(k.codespace, params.Subspace)
+	}
+
+	// the parameters are stored as JSON
+	bz := space.GetRaw(ctx, []byte(params.Key))
+	if bz == nil {
+		return nil, types.ErrUnknownParam(k.codespace, params.Subspace, params.Key)
+	}
+
+	return bz, nil
+}
+
+func querySubspace(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	space, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, types.ErrUnknownSubspace(k.codespace, params.Subspace)
+	}
+
+	values := make(map[string]json.RawMessage)
+	space.IterateRaw(ctx, func(key, value []byte) bool {
+		values[string(key)] = value
+		return false
+	})
+
+	res, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryScheduledChanges(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	changes := k.GetScheduledParamChanges(ctx)
+	if changes == nil {
+		changes = []types.ScheduledParamChange{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParamHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	records := k.GetParamHistory(ctx, params.Subspace, params.Key)
+	if records == nil {
+		records = []types.ParamChangeRecord{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/pokt-network/posmint/x/params/types"
+)
+
+// QueryParamJSON returns the JSON of the parameter key of subspace
+func QueryParamJSON(clientCtx util.ClientContext, cdc *codec.Codec, subspace, key string, height int64) (json.RawMessage, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryParamParams(subspace, key))
+	if err != nil {
+		return nil, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParam)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QuerySubspaceJSON returns the JSON of every parameter of subspace, by key
+func QuerySubspaceJSON(clientCtx util.ClientContext, cdc *codec.Codec, subspace string, height int64) (map[string]json.RawMessage, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryParamParams(subspace, ""))
+	if err != nil {
+		return nil, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubspace)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(res, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func QueryScheduledParamChanges(clientCtx util.ClientContext, cdc *codec.Codec, height int64) ([]types.ScheduledParamChange, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryScheduledChanges)
+	res, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+	var changes []types.ScheduledParamChange
+	if err := cdc.UnmarshalJSON(res, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func QueryParamChangeHistory(clientCtx util.ClientContext, cdc *codec.Codec, subspace, key string, height int64) ([]types.ParamChangeRecord, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryParamParams(subspace, key))
+	if err != nil {
+		return nil, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParamHistory)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+	var records []types.ParamChangeRecord
+	if err := cdc.UnmarshalJSON(res, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,159 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/params/types"
+)
+
+// ScheduleParamChange queues a change of a param, applied at the beginning of the block at
+// change.Height. A later schedule of the same param at the same height replaces it.
+// The change is validated against the current value of the param, and again when applied.
+func (k Keeper) ScheduleParamChange(ctx sdk.Context, change types.ScheduledParamChange) sdk.Error {
+	if change.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidScheduleHeight(k.codespace, change.Height, ctx.BlockHeight())
+	}
+	space, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return types.ErrUnknownSubspace(k.codespace, change.Subspace)
+	}
+	if err := space.ValidateUpdate(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return types.ErrSettingParameter(k.codespace, change.Key, "", change.Value, err.Error())
+	}
+	store := ctx.KVStore(k.key)
+	store.Set(types.KeyForScheduledChange(change.Height, change.Subspace, change.Key), k.cdc.MustMarshalBinaryLengthPrefixed(change))
+	return nil
+}
+
+// CancelScheduledParamChange removes a change from the queue before it is applied
+func (k Keeper) CancelScheduledParamChange(ctx sdk.Context, height int64, subspace, key string) sdk.Error {
+	store := ctx.KVStore(k.key)
+	storeKey := types.KeyForScheduledChange(height, subspace, key)
+	if !store.Has(storeKey) {
+		return types.ErrNoScheduledChange(k.codespace, height, subspace, key)
+	}
+	store.Delete(storeKey)
+	return nil
+}
+
+// IterateScheduledParamChanges iterates over the queued changes by height, until cb returns true
+func (k Keeper) IterateScheduledParamChanges(ctx sdk.Context, cb func(change types.ScheduledParamChange) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduledChangeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledParamChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		if cb(change) {
+			return
+		}
+	}
+}
+
+// GetScheduledParamChanges returns the queued changes by height
+func (k Keeper) GetScheduledParamChanges(ctx sdk.Context) (changes []types.ScheduledParamChange) {
+	k.IterateScheduledParamChanges(ctx, func(change types.ScheduledParamChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	return changes
+}
+
+// ApplyScheduledParamChanges applies and dequeues the changes scheduled up to the current height.
+// A change that became invalid since it was scheduled is dropped, and the failure emitted.
+func (k Keeper) ApplyScheduledParamChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(types.ScheduledChangeKey, types.KeyForScheduledChangesAt(ctx.BlockHeight()+1))
+	var changes []types.ScheduledParamChange
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledParamChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		changes = append(changes, change)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i, change := range changes {
+		store.Delete(keys[i])
+		if err := k.applyScheduledParamChange(ctx, change); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("scheduled change of parameter %s/%s dropped: %s", change.Subspace, change.Key, err))
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeParamChangeFailed,
+				sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+				sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeParamChange,
+			sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+			sdk.NewAttribute(types.AttributeKeySource, change.Source),
+		))
+	}
+}
+
+// applies a single change, writing nothing if it fails
+func (k Keeper) applyScheduledParamChange(ctx sdk.Context, change types.ScheduledParamChange) error {
+	space, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return types.ErrUnknownSubspace(k.codespace, change.Subspace)
+	}
+	source := types.SourceScheduled
+	if change.Source != "" {
+		source = types.SourceScheduled + ":" + change.Source
+	}
+	return space.UpdateWithSource(ctx, []byte(change.Key), []byte(change.Value), source)
+}
+
+// IterateParamHistory iterates over the changes of a param by height, until cb returns true
+func (k Keeper) IterateParamHistory(ctx sdk.Context, subspace, key string, cb func(record types.ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChangeHistoryPrefix(subspace, key))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// IterateAllParamHistory iterates over the changes of every param, until cb returns true
+func (k Keeper) IterateAllParamHistory(ctx sdk.Context, cb func(record types.ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChangeHistoryKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// GetParamHistory returns the changes of a param by height
+func (k Keeper) GetParamHistory(ctx sdk.Context, subspace, key string) (records []types.ParamChangeRecord) {
+	k.IterateParamHistory(ctx, subspace, key, func(record types.ParamChangeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetParamAtHeight returns the last change of a param at or before height, the value the param had at height
+func (k Keeper) GetParamAtHeight(ctx sdk.Context, subspace, key string, height int64) (record types.ParamChangeRecord, found bool) {
+	store := ctx.KVStore(k.key)
+	iterator := store.ReverseIterator(types.ChangeHistoryPrefix(subspace, key), types.KeyForChangeHistory(subspace, key, height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return record, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+	return record, true
+}
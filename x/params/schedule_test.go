@@ -0,0 +1,149 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestScheduledParamChanges(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	ctx = ctx.WithBlockHeight(1)
+	space.SetParamSet(ctx, &validatedParams{Positive: 1})
+
+	// invalid schedules
+	require.NotNil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(1, "test", "positive", `"2"`, "")))
+	require.NotNil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(5, "unknown", "positive", `"2"`, "")))
+	require.NotNil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(5, "test", "unknown", `"2"`, "")))
+	require.NotNil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(5, "test", "positive", `"-2"`, "")))
+
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(5, "test", "positive", `"5"`, "upgrade")))
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(3, "test", "positive", `"3"`, "")))
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(4, "test", "any", `"cancelled"`, "")))
+	require.Nil(t, keeper.CancelScheduledParamChange(ctx, 4, "test", "any"))
+	require.NotNil(t, keeper.CancelScheduledParamChange(ctx, 4, "test", "any"))
+	changes := keeper.GetScheduledParamChanges(ctx)
+	require.Len(t, changes, 2)
+	require.Equal(t, int64(3), changes[0].Height, "the changes are ordered by height")
+
+	var param int64
+	for height := int64(2); height <= 6; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		BeginBlocker(ctx, keeper)
+		space.Get(ctx, []byte("positive"), &param)
+		switch {
+		case height < 3:
+			require.Equal(t, int64(1), param)
+		case height < 5:
+			require.Equal(t, int64(3), param)
+		default:
+			require.Equal(t, int64(5), param)
+		}
+	}
+	require.Empty(t, keeper.GetScheduledParamChanges(ctx))
+
+	history := keeper.GetParamHistory(ctx, "test", "positive")
+	require.Len(t, history, 3)
+	require.Equal(t, int64(1), history[0].Height)
+	require.Equal(t, SourceSet, history[0].Source)
+	require.Equal(t, int64(3), history[1].Height)
+	require.Equal(t, SourceScheduled, history[1].Source)
+	require.Equal(t, `"5"`, history[2].Value)
+	require.Equal(t, SourceScheduled+":upgrade", history[2].Source)
+
+	record, found := keeper.GetParamAtHeight(ctx, "test", "positive", 4)
+	require.True(t, found)
+	require.Equal(t, `"3"`, record.Value)
+	_, found = keeper.GetParamAtHeight(ctx, "test", "positive", 0)
+	require.False(t, found)
+}
+
+func TestScheduledParamChangeBecameInvalid(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	space.SetParamSet(ctx, &validatedParams{Positive: 1})
+
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(2, "test", "positive", `"2"`, "")))
+	// overwrite the queued change with an invalid one, as a change of the validators would
+	store := ctx.KVStore(keeper.key)
+	invalid := NewScheduledParamChange(2, "test", "positive", `"-2"`, "")
+	store.Set(KeyForScheduledChange(2, "test", "positive"), keeper.cdc.MustMarshalBinaryLengthPrefixed(invalid))
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+	var param int64
+	space.Get(ctx, []byte("positive"), &param)
+	require.Equal(t, int64(1), param)
+	require.Empty(t, keeper.GetScheduledParamChanges(ctx))
+	require.Len(t, keeper.GetParamHistory(ctx, "test", "positive"), 1)
+}
+
+func TestQueryParamHistory(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	space.SetParamSet(ctx, &validatedParams{Positive: 1})
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(2, "test", "positive", `"2"`, "")))
+	querier := NewQuerier(keeper)
+
+	bz, err := querier(ctx, []string{QueryScheduledChanges}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var changes []ScheduledParamChange
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &changes))
+	require.Len(t, changes, 1)
+
+	data := ModuleCdc.MustMarshalJSON(NewQueryParamParams("test", "positive"))
+	bz, err = querier(ctx, []string{QueryParamHistory}, abci.RequestQuery{Data: data})
+	require.Nil(t, err)
+	var records []ParamChangeRecord
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &records))
+	require.Len(t, records, 1)
+	require.Equal(t, `"1"`, records[0].Value)
+}
+
+func TestParamHistorySubkeys(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	space.Set(ctx, []byte("any"), "value")
+	space.SetWithSubkey(ctx, []byte("any"), []byte("sub"), "subvalue")
+
+	history := keeper.GetParamHistory(ctx, "test", "any")
+	require.Len(t, history, 1, "the history of a param excludes its subkeys")
+	require.Equal(t, `"value"`, history[0].Value)
+	history = keeper.GetParamHistory(ctx, "test", "any/sub")
+	require.Len(t, history, 1)
+	require.Equal(t, `"subvalue"`, history[0].Value)
+}
+
+func TestParamsGenesis(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	ctx = ctx.WithBlockHeight(1)
+	space.SetParamSet(ctx, &validatedParams{Positive: 1})
+	require.Nil(t, keeper.ScheduleParamChange(ctx, NewScheduledParamChange(5, "test", "positive", `"5"`, "upgrade")))
+	exported := ExportGenesis(ctx, keeper)
+	require.Len(t, exported.ScheduledChanges, 1)
+	require.Len(t, exported.ChangeHistory, 2)
+	require.NoError(t, ValidateGenesis(exported))
+
+	_, ctx, _, _, keeper = testComponents()
+	space = keeper.Subspace("test").WithKeyTable(NewKeyTable().RegisterParamSet(&validatedParams{}))
+	InitGenesis(ctx, keeper, exported)
+	require.Equal(t, exported, ExportGenesis(ctx, keeper))
+
+	ctx = ctx.WithBlockHeight(5)
+	space.SetParamSet(ctx.WithBlockHeight(0), &validatedParams{Positive: 1})
+	BeginBlocker(ctx, keeper)
+	var param int64
+	space.Get(ctx, []byte("positive"), &param)
+	require.Equal(t, int64(5), param)
+
+	require.Error(t, ValidateGenesis(NewGenesisState([]ScheduledParamChange{NewScheduledParamChange(5, "", "positive", `"5"`, "")}, nil)))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil, []ParamChangeRecord{{Subspace: "test", Key: "positive", Height: -1, Value: `"1"`}})))
+}
@@ -1,9 +1,22 @@
 package subspace
 
+// Validates a parameter value before it is stored, the value is never a pointer
+type ValueValidatorFn func(value interface{}) error
+
 // Used for associating paramsubspace key and field of param structs
 type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
+	Key         []byte
+	Value       interface{}
+	ValidatorFn ValueValidatorFn // optional, nil stores any well-typed value
+}
+
+// NewParamSetPair creates a new ParamSetPair object
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{
+		Key:         key,
+		Value:       value,
+		ValidatorFn: vfn,
+	}
 }
 
 // Slice of KeyFieldPair
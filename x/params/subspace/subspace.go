@@ -2,10 +2,12 @@ package subspace
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/params/types"
 
 	"github.com/pokt-network/posmint/store/prefix"
 )
@@ -70,16 +72,21 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.storePrefix())
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.storePrefix())
+}
+
+// Returns the prefix of the subspace in the stores. It is a new slice, as appending to
+// s.name could write to its spare capacity, shared with the txs delivered concurrently.
+func (s Subspace) storePrefix() []byte {
+	res := make([]byte, len(s.name)+1)
+	copy(res, s.name)
+	res[len(s.name)] = '/'
+	return res
 }
 
 func concatKeys(key, subkey []byte) (res []byte) {
@@ -130,6 +137,18 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// IterateRaw iterates over the raw bytes of every parameter set in the subspace, subkeyed
+// ones included, in key order, until cb returns true
+func (s Subspace) IterateRaw(ctx sdk.Context, cb func(key, value []byte) (stop bool)) {
+	iterator := s.kvStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key(), iterator.Value()) {
+			return
+		}
+	}
+}
+
 // Check if the parameter is set in the store
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -159,65 +178,112 @@ func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	}
 }
 
-// Set stores the parameter. It returns error if stored parameter has different type from input.
-// It also set to the transient store to record change.
+// Validate runs the validator registered for the key on the parameter, if any
+func (s Subspace) Validate(key []byte, param interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s not registered", key)
+	}
+	if attr.vfn == nil {
+		return nil
+	}
+	if err := attr.vfn(reflect.Indirect(reflect.ValueOf(param)).Interface()); err != nil {
+		return fmt.Errorf("invalid parameter %s: %s", key, err)
+	}
+	return nil
+}
+
+// Set stores the parameter. It panics if the stored parameter has a different type from
+// the input or if the input fails the validation of the key.
+// It also set to the transient store to record change, and records it in the history.
 func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
+	s.set(ctx, key, key, param, types.SourceSet)
+}
+
+// sets the parameter at storeKey, key or key/subkey, checking it over key
+func (s Subspace) set(ctx sdk.Context, key, storeKey []byte, param interface{}, source string) {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
+	if err := s.Validate(key, param); err != nil {
+		panic(err)
+	}
 
 	bz, err := s.cdc.MarshalJSON(param)
 	if err != nil {
 		panic(err)
 	}
-	store.Set(key, bz)
+	store.Set(storeKey, bz)
 
 	tstore := s.transientStore(ctx)
-	tstore.Set(key, []byte{})
+	tstore.Set(storeKey, []byte{})
+
+	s.recordChange(ctx, storeKey, bz, source)
+}
 
+// records the change of the parameter in the history, overriding a change of the same block
+func (s Subspace) recordChange(ctx sdk.Context, storeKey []byte, bz []byte, source string) {
+	record := types.ParamChangeRecord{
+		Subspace: s.Name(),
+		Key:      string(storeKey),
+		Height:   ctx.BlockHeight(),
+		Value:    string(bz),
+		Source:   source,
+	}
+	ctx.KVStore(s.key).Set(types.KeyForChangeHistory(record.Subspace, record.Key, record.Height), s.cdc.MustMarshalBinaryLengthPrefixed(record))
 }
 
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or if the input fails the validation of
+// the key. It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
-	attr, ok := s.table.m[string(key)]
-	if !ok {
+	return s.UpdateWithSource(ctx, key, param, types.SourceUpdate)
+}
+
+// UpdateWithSource is Update, recording source as the origin of the change in the history
+func (s Subspace) UpdateWithSource(ctx sdk.Context, key []byte, param []byte, source string) error {
+	if _, ok := s.table.m[string(key)]; !ok {
 		panic("Parameter not registered")
 	}
 
-	ty := attr.ty
-	dest := reflect.New(ty).Interface()
-	s.GetIfExists(ctx, key, dest)
-	err := s.cdc.UnmarshalJSON(param, dest)
+	dest, err := s.decodeUpdate(ctx, key, key, param)
 	if err != nil {
 		return err
 	}
 
-	s.Set(ctx, key, dest)
-	tStore := s.transientStore(ctx)
-	tStore.Set(key, []byte{})
-
+	s.set(ctx, key, key, dest, source)
 	return nil
 }
 
-// SetWithSubkey set a parameter with a key and subkey
-// Checks parameter type only over the key
-func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
-	store := s.kvStore(ctx)
-
-	s.checkType(store, key, param)
-
-	newkey := concatKeys(key, subkey)
+// ValidateUpdate returns the error Update would return for the raw parameter bytes,
+// without storing them
+func (s Subspace) ValidateUpdate(ctx sdk.Context, key []byte, param []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		return fmt.Errorf("parameter %s not registered", key)
+	}
+	_, err := s.decodeUpdate(ctx, key, key, param)
+	return err
+}
 
-	bz, err := s.cdc.MarshalJSON(param)
+// decodes raw parameter bytes over the stored parameter at storeKey, and validates them over key
+func (s Subspace) decodeUpdate(ctx sdk.Context, key, storeKey []byte, param []byte) (interface{}, error) {
+	ty := s.table.m[string(key)].ty
+	dest := reflect.New(ty).Interface()
+	s.GetIfExists(ctx, storeKey, dest)
+	err := s.cdc.UnmarshalJSON(param, dest)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	if err := s.Validate(key, dest); err != nil {
+		return nil, err
 	}
-	store.Set(newkey, bz)
+	return dest, nil
+}
 
-	tstore := s.transientStore(ctx)
-	tstore.Set(newkey, []byte{})
+// SetWithSubkey set a parameter with a key and subkey
+// Checks parameter type and validates it only over the key
+func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
+	s.set(ctx, key, concatKeys(key, subkey), param, types.SourceSet)
 }
 
 // UpdateWithSubkey stores raw parameter bytes  with a key and subkey. It checks
@@ -225,23 +291,16 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param []byte) error {
 	concatkey := concatKeys(key, subkey)
 
-	attr, ok := s.table.m[string(concatkey)]
-	if !ok {
+	if _, ok := s.table.m[string(concatkey)]; !ok {
 		return errors.New("parameter not registered")
 	}
 
-	ty := attr.ty
-	dest := reflect.New(ty).Interface()
-	s.GetWithSubkeyIfExists(ctx, key, subkey, dest)
-	err := s.cdc.UnmarshalJSON(param, dest)
+	dest, err := s.decodeUpdate(ctx, concatkey, concatkey, param)
 	if err != nil {
 		return err
 	}
 
-	s.SetWithSubkey(ctx, key, subkey, dest)
-	tStore := s.transientStore(ctx)
-	tStore.Set(concatkey, []byte{})
-
+	s.set(ctx, key, concatkey, dest, types.SourceUpdate)
 	return nil
 }
 
@@ -5,7 +5,8 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty  reflect.Type
+	vfn ValueValidatorFn
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -43,6 +44,11 @@ func isAlphaNumeric(key []byte) bool {
 
 // Register single key-type pair
 func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
+	return t.RegisterTypeWithValidator(key, ty, nil)
+}
+
+// Register single key-type pair, with the validator of the values stored under the key
+func (t KeyTable) RegisterTypeWithValidator(key []byte, ty interface{}, vfn ValueValidatorFn) KeyTable {
 	if len(key) == 0 {
 		panic("cannot register empty key")
 	}
@@ -62,7 +68,8 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	}
 
 	t.m[keystr] = attribute{
-		ty: rty,
+		ty:  rty,
+		vfn: vfn,
 	}
 
 	return t
@@ -71,7 +78,7 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
-		t = t.RegisterType(kvp.Key, kvp.Value)
+		t = t.RegisterTypeWithValidator(kvp.Key, kvp.Value, kvp.ValidatorFn)
 	}
 	return t
 }
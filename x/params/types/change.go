@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+)
+
+// sources of a param change
+const (
+	SourceSet       = "set"       // set by the keeper owning the subspace, or at genesis
+	SourceUpdate    = "update"    // updated from raw JSON by a master keeper
+	SourceScheduled = "scheduled" // applied from the queue of scheduled changes
+)
+
+// ScheduledParamChange - a param change applied at the beginning of the block at Height
+type ScheduledParamChange struct {
+	Height   int64  `json:"height" yaml:"height"`
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Value    string `json:"value" yaml:"value"`   // JSON of the new value, as given to Subspace.Update
+	Source   string `json:"source" yaml:"source"` // who scheduled the change
+}
+
+// NewScheduledParamChange creates a new ScheduledParamChange object
+func NewScheduledParamChange(height int64, subspace, key, value, source string) ScheduledParamChange {
+	return ScheduledParamChange{
+		Height:   height,
+		Subspace: subspace,
+		Key:      key,
+		Value:    value,
+		Source:   source,
+	}
+}
+
+// String returns a human readable string representation of the change.
+func (c ScheduledParamChange) String() string {
+	return fmt.Sprintf(`ScheduledParamChange:
+  Height:   %d
+  Subspace: %s
+  Key:      %s
+  Value:    %s
+  Source:   %s`, c.Height, c.Subspace, c.Key, c.Value, c.Source)
+}
+
+// ParamChangeRecord - an entry of the history of a param, the value it took at Height.
+// Only the last change of a param within a block is recorded.
+type ParamChangeRecord struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Height   int64  `json:"height" yaml:"height"`
+	Value    string `json:"value" yaml:"value"` // JSON of the value stored
+	Source   string `json:"source" yaml:"source"`
+}
+
+// String returns a human readable string representation of the record.
+func (r ParamChangeRecord) String() string {
+	return fmt.Sprintf(`ParamChangeRecord:
+  Subspace: %s
+  Key:      %s
+  Height:   %d
+  Value:    %s
+  Source:   %s`, r.Subspace, r.Key, r.Height, r.Value, r.Source)
+}
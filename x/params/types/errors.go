@@ -13,6 +13,8 @@ const (
 	CodeUnknownSubspace  sdk.CodeType = 1
 	CodeSettingParameter sdk.CodeType = 2
 	CodeEmptyData        sdk.CodeType = 3
+	CodeUnknownParam     sdk.CodeType = 4
+	CodeInvalidSchedule  sdk.CodeType = 5
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -20,6 +22,21 @@ func ErrUnknownSubspace(codespace sdk.CodespaceType, space string) sdk.Error {
 	return sdk.NewError(codespace, CodeUnknownSubspace, fmt.Sprintf("unknown subspace %s", space))
 }
 
+// ErrUnknownParam returns an unknown parameter error.
+func ErrUnknownParam(codespace sdk.CodespaceType, space, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownParam, fmt.Sprintf("unknown parameter %s in subspace %s", key, space))
+}
+
+// ErrInvalidScheduleHeight returns an error for a change scheduled at a past height.
+func ErrInvalidScheduleHeight(codespace sdk.CodespaceType, height, current int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, fmt.Sprintf("param change scheduled at height %d, which is not after the current height %d", height, current))
+}
+
+// ErrNoScheduledChange returns an error for a missing scheduled change.
+func ErrNoScheduledChange(codespace sdk.CodespaceType, height int64, space, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, fmt.Sprintf("no change of parameter %s in subspace %s scheduled at height %d", key, space, height))
+}
+
 // ErrSettingParameter returns an error for failing to set a parameter.
 func ErrSettingParameter(codespace sdk.CodespaceType, key, subkey, value, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeSettingParameter, fmt.Sprintf("error setting parameter %s on %s (%s): %s", value, key, subkey, msg))
@@ -0,0 +1,12 @@
+package types
+
+// params module event types
+var (
+	EventTypeParamChange       = "param_change"
+	EventTypeParamChangeFailed = "param_change_failed"
+
+	AttributeKeySubspace = "subspace"
+	AttributeKeyKey      = "key"
+	AttributeKeySource   = "source"
+	AttributeKeyError    = "error"
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - the param changes not held by the subspaces: the changes still scheduled,
+// and the history of the changes applied. The params themselves are in the genesis of the
+// modules owning them.
+type GenesisState struct {
+	ScheduledChanges []ScheduledParamChange `json:"scheduled_changes" yaml:"scheduled_changes"`
+	ChangeHistory    []ParamChangeRecord    `json:"change_history" yaml:"change_history"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(scheduledChanges []ScheduledParamChange, changeHistory []ParamChangeRecord) GenesisState {
+	return GenesisState{
+		ScheduledChanges: scheduledChanges,
+		ChangeHistory:    changeHistory,
+	}
+}
+
+// DefaultGenesisState returns a genesis state with no scheduled change and no history
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ScheduledChanges: []ScheduledParamChange{},
+		ChangeHistory:    []ParamChangeRecord{},
+	}
+}
+
+// ValidateGenesis checks that every change names a param and has a value
+func ValidateGenesis(data GenesisState) error {
+	for _, change := range data.ScheduledChanges {
+		if change.Subspace == "" || change.Key == "" || change.Value == "" {
+			return fmt.Errorf("invalid scheduled param change, the subspace, key and value must be set: %v", change)
+		}
+		if change.Height < 0 {
+			return fmt.Errorf("invalid scheduled param change, the height must not be negative: %v", change)
+		}
+	}
+	for _, record := range data.ChangeHistory {
+		if record.Subspace == "" || record.Key == "" || record.Value == "" {
+			return fmt.Errorf("invalid param change record, the subspace, key and value must be set: %v", record)
+		}
+		if record.Height < 0 {
+			return fmt.Errorf("invalid param change record, the height must not be negative: %v", record)
+		}
+	}
+	return nil
+}
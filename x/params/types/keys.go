@@ -1,9 +1,59 @@
 package types
 
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
 const (
 	// ModuleKey defines the name of the module
 	ModuleName = "params"
 
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
+
+	// QuerierRoute defines the querier route of the params module
+	QuerierRoute = "params"
+)
+
+// The scheduled changes and the change history share the param store with the
+// subspaces, whose names never start with these bytes
+var (
+	ScheduledChangeKey = []byte{0x01} // prefix for the param changes scheduled at a future height
+	ChangeHistoryKey   = []byte{0x02} // prefix for the history of the param changes
 )
+
+// generates the key of a param change scheduled at height, ordered by height
+func KeyForScheduledChange(height int64, subspace, key string) []byte {
+	return append(KeyForScheduledChangesAt(height), []byte(subspace+"/"+key)...)
+}
+
+// generates the prefix of the param changes scheduled at height
+func KeyForScheduledChangesAt(height int64) []byte {
+	return append(ScheduledChangeKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the key of the change of a param at height, ordered by height within the param
+func KeyForChangeHistory(subspace, key string, height int64) []byte {
+	return append(ChangeHistoryPrefix(subspace, key), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the prefix of the change history of a param. The subspace and the key are length
+// prefixed, so the prefix of a param is never the prefix of a subkeyed param "key/subkey"
+func ChangeHistoryPrefix(subspace, key string) []byte {
+	res := append([]byte{}, ChangeHistoryKey...)
+	res = append(res, lengthPrefixed(subspace)...)
+	return append(res, lengthPrefixed(key)...)
+}
+
+// prefixes s with its length as a big endian uint16
+func lengthPrefixed(s string) []byte {
+	if len(s) > 0xFFFF {
+		panic(fmt.Sprintf("%s exceeds the maximum length of a param key", s))
+	}
+	res := make([]byte, 2, 2+len(s))
+	binary.BigEndian.PutUint16(res, uint16(len(s)))
+	return append(res, s...)
+}
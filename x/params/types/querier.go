@@ -0,0 +1,24 @@
+package types
+
+// query endpoints supported by the params Querier
+const (
+	QueryParam            = "param"
+	QuerySubspace         = "subspace"
+	QueryScheduledChanges = "scheduled_changes"
+	QueryParamHistory     = "param_history"
+)
+
+// QueryParamParams - the subspace, and the key within it, of the parameter to query.
+// The key is ignored when querying a whole subspace, the history of a param uses both.
+type QueryParamParams struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+}
+
+// NewQueryParamParams creates a new QueryParamParams object
+func NewQueryParamParams(subspace, key string) QueryParamParams {
+	return QueryParamParams{
+		Subspace: subspace,
+		Key:      key,
+	}
+}
@@ -120,6 +120,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, supplyKeeper types.Suppl
 	if data.PreviousProposer != nil {
 		keeper.SetPreviousProposer(ctx, data.PreviousProposer)
 	}
+	// restore the slash distribution totals if provided
+	if !data.SlashTotals.IsNil() {
+		keeper.SetSlashTotals(ctx, data.SlashTotals)
+	}
 	return res
 }
 
@@ -151,6 +155,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	daoTokens := keeper.GetDAOTokens(ctx)
 	daoPool := types.DAOPool{Tokens: daoTokens}
 	prevProposer := keeper.GetPreviousProposer(ctx)
+	slashTotals := keeper.GetSlashTotals(ctx)
 
 	return types.GenesisState{
 		Params:                   params,
@@ -162,6 +167,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		SlashTotals:              slashTotals,
 	}
 }
 
@@ -201,6 +207,11 @@ func ValidateGenesis(data types.GenesisState) error {
 		return fmt.Errorf("Downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
 	}
 
+	if !data.SlashTotals.IsNil() && (data.SlashTotals.Burned.IsNegative() ||
+		data.SlashTotals.DAOAllocation.IsNegative() || data.SlashTotals.ReporterBounty.IsNegative()) {
+		return fmt.Errorf("Slash totals must not be negative, are %s", data.SlashTotals.String())
+	}
+
 	signedWindow := data.Params.SignedBlocksWindow
 	if signedWindow < 10 {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
@@ -21,6 +21,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUnjail(ctx, msg, k)
 		case types.MsgSend:
 			return handleMsgSend(ctx, msg, k)
+		case types.MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -212,3 +214,17 @@ func handleMsgSend(ctx sdk.Context, msg types.MsgSend, k keeper.Keeper) sdk.Resu
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgSubmitEvidence(ctx sdk.Context, msg types.MsgSubmitEvidence, k keeper.Keeper) sdk.Result {
+	if err := k.HandleSubmittedEvidence(ctx, msg.Evidence, msg.Reporter); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Reporter.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
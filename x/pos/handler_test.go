@@ -0,0 +1,103 @@
+package pos
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/posmint/baseapp"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/bank"
+	"github.com/pokt-network/posmint/x/params"
+	"github.com/pokt-network/posmint/x/pos/keeper"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/pokt-network/posmint/x/supply"
+)
+
+// Test that the pos msgs can be delivered concurrently by baseapp.DeliverTxs, the handlers of
+// the txs sharing the validator cache of the keeper. Run with -race.
+func TestDeliverTxsConcurrentValidatorReads(t *testing.T) {
+	// run the workers in parallel, even on a single cpu
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	var validators []types.Validator
+	for i := 0; i < 600; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validators = append(validators, types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(1)))
+	}
+
+	newApp := func(workers int) *baseapp.BaseApp {
+		keyMain := sdk.NewKVStoreKey("main")
+		keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+		keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+		keyParams := sdk.NewKVStoreKey(params.StoreKey)
+		tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+		keyPOS := sdk.NewKVStoreKey(types.StoreKey)
+
+		app := baseapp.NewBaseApp("pos", log.NewNopLogger(), dbm.NewMemDB(), auth.DefaultTxDecoder(cdc))
+		app.SetParallelDeliverTx(workers)
+		maccPerms := map[string][]string{
+			auth.FeeCollectorName: nil,
+			types.StakedPoolName:  {supply.Burner, supply.Staking},
+			types.DAOPoolName:     nil,
+			types.ModuleName:      {supply.Minter},
+		}
+		pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+		ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+		bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, map[string]bool{})
+		sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
+		k := keeper.NewKeeper(cdc, keyPOS, bk, sk, pk.Subspace(keeper.DefaultParamspace), types.DefaultCodespace)
+
+		app.Router().AddRoute(types.RouterKey, NewHandler(k))
+		// the txs are metered on their own gas meters, so they can be committed without being executed again
+		app.SetAnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, sdk.Result, bool) {
+			return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), sdk.Result{}, false
+		})
+		app.SetInitChainer(func(ctx sdk.Context, _ abci.RequestInitChain) abci.ResponseInitChain {
+			k.SetParams(ctx, types.DefaultParams())
+			for _, validator := range validators {
+				k.SetValidator(ctx, validator)
+			}
+			return abci.ResponseInitChain{}
+		})
+		app.MountStores(keyMain, keyAcc, keySupply, keyParams, tkeyParams, keyPOS)
+		require.NoError(t, app.LoadLatestVersion(keyMain))
+		app.InitChain(abci.RequestInitChain{})
+		return app
+	}
+
+	// every unjail reads its validator through the cache, more validators than it holds, and fails
+	// as the validator isn't jailed
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 1200; i++ {
+		msg := types.MsgUnjail{ValidatorAddr: validators[i%len(validators)].Address}
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(auth.NewStdTx([]sdk.Msg{msg}, sdk.NewCoins(), nil, ""))
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	serialApp, parallelApp := newApp(0), newApp(8)
+	header := abci.Header{Height: 1}
+	serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	expected := serialApp.DeliverTxs(reqs)
+	require.Equal(t, uint32(types.CodeValidatorNotJailed), expected[0].Code)
+	require.Equal(t, expected, parallelApp.DeliverTxs(reqs))
+	serialApp.EndBlock(abci.RequestEndBlock{})
+	parallelApp.EndBlock(abci.RequestEndBlock{})
+	require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data)
+}
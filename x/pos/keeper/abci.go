@@ -10,8 +10,11 @@ import (
 
 // 1) allocate tokens to block producer
 // 2) mint any custom awards for each validator
-// 3) set new proposer
-// 4) check block sigs and byzantine evidence to slash
+// 3) release validators whose jail term has expired (if enabled)
+// 4) prune expired slash and jail history
+// 5) snapshot the header and validator set and prune expired snapshots
+// 6) set new proposer
+// 7) check block sigs and byzantine evidence to slash
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	// reward the proposer with fees
 	if ctx.BlockHeight() > 1 {
@@ -22,6 +25,12 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	k.mintValidatorAwards(ctx)
 	// burn any custom validator slashes
 	k.burnValidators(ctx)
+	// release any validators whose jail term has expired
+	k.autoUnjailValidators(ctx)
+	// prune slash and jail history outside of the retention window
+	k.pruneValidatorHistory(ctx)
+	// snapshot the header and validator set of this block for historical lookups
+	k.trackHistoricalInfo(ctx)
 	// record the new proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposer(ctx, consAddr)
@@ -39,7 +48,11 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	for _, evidence := range req.ByzantineValidators {
 		switch evidence.Type {
 		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			k.handleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
+			// evidence already handled (e.g. submitted in a transaction), too old or of an unknown validator is skipped
+			if err := k.handleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power, nil); err != nil {
+				k.Logger(ctx).Info(fmt.Sprintf("ignored double sign evidence of %s at height %d: %s",
+					sdk.ConsAddress(evidence.Validator.Address), evidence.Height, err.Error()))
+			}
 		default:
 			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
 		}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	kb "github.com/pokt-network/posmint/crypto/keys"
 	"github.com/pokt-network/posmint/types/module"
 	"github.com/pokt-network/posmint/x/supply"
 	"testing"
@@ -9,7 +8,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
-	"github.com/tendermint/tendermint/node"
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -31,8 +29,6 @@ var (
 	multiPerm    = "multiple permissions account"
 	randomPerm   = "random permission"
 	holder       = "holder"
-	Keybase      kb.Keybase
-	TMNode       *node.Node
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
 		bank.AppModuleBasic{},
@@ -48,6 +44,7 @@ func makeTestCodec() *codec.Codec {
 
 	bank.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 
@@ -56,6 +53,13 @@ func makeTestCodec() *codec.Codec {
 
 // nolint: deadcode unused
 func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64) (sdk.Context, []auth.Account, Keeper) {
+	ctx, accs, keeper, _ := createTestInputWithParamsKeeper(t, isCheckTx, initPower, nAccs)
+	return ctx, accs, keeper
+}
+
+// createTestInput, also returning the params keeper for tests allocating their own subspaces
+// nolint: deadcode unused
+func createTestInputWithParamsKeeper(t *testing.T, isCheckTx bool, initPower int64, nAccs int64) (sdk.Context, []auth.Account, Keeper, params.Keeper) {
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
@@ -83,6 +87,8 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64)
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName: nil,
 		types.StakedPoolName:  {supply.Burner, supply.Staking},
+		types.DAOPoolName:     nil,
+		types.ModuleName:      {supply.Minter},
 	}
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
@@ -96,9 +102,9 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64)
 	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
 
 	moduleManager := module.NewManager(
-		auth.NewAppModule(ak, TMNode, Keybase),
-		bank.NewAppModule(bk, ak, TMNode, Keybase),
-		supply.NewAppModule(sk, ak, TMNode, Keybase),
+		auth.NewAppModule(ak),
+		bank.NewAppModule(bk, ak),
+		supply.NewAppModule(sk, ak),
 	)
 
 	genesisState := ModuleBasics.DefaultGenesis()
@@ -110,9 +116,8 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64)
 
 	keeper := NewKeeper(cdc, keySupply, bk, sk, posSubSpace, sdk.CodespaceType("pos"))
 
-	params := types.DefaultParams()
-	keeper.SetParams(ctx, params)
-	return ctx, accs, keeper
+	keeper.SetParams(ctx, types.DefaultParams())
+	return ctx, accs, keeper, pk
 }
 
 // nolint: unparam deadcode unused
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pokt-network/posmint/x/pos/types"
+)
+
+// DecodeStore decodes the validators and the signing infos of the staking
+// store, see sdk.StoreDecoder.
+func (k Keeper) DecodeStore(key, value []byte) (string, json.RawMessage, bool) {
+	var (
+		typ string
+		obj interface{}
+	)
+	switch {
+	case bytes.HasPrefix(key, types.AllValidatorsKey):
+		validator, err := types.UnmarshalValidator(k.cdc, value)
+		if err != nil {
+			return "", nil, false
+		}
+		typ, obj = "validator", validator
+	case bytes.HasPrefix(key, types.ValidatorSigningInfoKey):
+		var info types.ValidatorSigningInfo
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(value, &info); err != nil {
+			return "", nil, false
+		}
+		typ, obj = "signing_info", info
+	default:
+		return "", nil, false
+	}
+	bz, err := k.cdc.MarshalJSON(obj)
+	if err != nil {
+		return "", nil, false
+	}
+	return typ, bz, true
+}
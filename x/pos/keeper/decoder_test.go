@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestDecodeStore(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.NewInt(100))
+	keeper.SetValidator(context, validator)
+	info := types.ValidatorSigningInfo{Address: sdk.ConsAddress(pubKey.Address()), StartHeight: 5}
+	keeper.SetValidatorSigningInfo(context, info.Address, info)
+	store := context.KVStore(keeper.storeKey)
+
+	key := types.KeyForValByAllVals(validator.Address)
+	typ, bz, ok := keeper.DecodeStore(key, store.Get(key))
+	assert.True(t, ok)
+	assert.Equal(t, "validator", typ)
+	var decodedValidator types.Validator
+	assert.NoError(t, keeper.cdc.UnmarshalJSON(bz, &decodedValidator))
+	assert.True(t, validator.StakedTokens.Equal(decodedValidator.StakedTokens))
+	assert.Equal(t, validator.Address, decodedValidator.Address)
+
+	key = types.GetValidatorSigningInfoKey(info.Address)
+	typ, bz, ok = keeper.DecodeStore(key, store.Get(key))
+	assert.True(t, ok)
+	assert.Equal(t, "signing_info", typ)
+	var decodedInfo types.ValidatorSigningInfo
+	assert.NoError(t, keeper.cdc.UnmarshalJSON(bz, &decodedInfo))
+	assert.Equal(t, info.StartHeight, decodedInfo.StartHeight)
+
+	_, _, ok = keeper.DecodeStore(types.SlashTotalsKey, []byte{1})
+	assert.False(t, ok)
+	_, _, ok = keeper.DecodeStore(key, []byte("garbage"))
+	assert.False(t, ok)
+}
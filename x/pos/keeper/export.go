@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+)
+
+// PrepareForZeroHeightGenesis adjusts the state before it is exported to relaunch a new chain from height zero:
+// 1) finishes the unstaking of every validator in the unstaking queue and removes the unstaked validators
+// 2) resets the signing info and missed blocks of every validator, as they refer to heights of the old chain
+// 3) clears the jail queue and ends the jail terms of the jailed validators that aren't tombstoned, as they refer
+// to times of the old chain; the validators stay jailed until they send an unjail message
+func (k Keeper) PrepareForZeroHeightGenesis(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// finish every unstaking, mature or not
+	for _, addr := range k.getUnstakingQueue(ctx) {
+		validator := k.mustGetValidator(ctx, addr)
+		if err := k.ValidateValidatorFinishUnstaking(ctx, validator); err != nil {
+			panic(err)
+		}
+		if err := k.FinishUnstakingValidator(ctx, validator); err != nil {
+			panic(err)
+		}
+	}
+	// unstaked validators can't be imported at genesis
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.IsUnstaked() {
+			store.Delete(types.KeyForValByAllVals(validator.Address))
+			store.Delete(types.KeyForValidatorByConsAddr(validator.ConsAddress()))
+		}
+	}
+	// reset the signing info
+	var addrs []sdk.ConsAddress
+	var infos []types.ValidatorSigningInfo
+	k.IterateAndExecuteOverValSigningInfo(ctx, func(addr sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+		addrs = append(addrs, addr)
+		infos = append(infos, info)
+		return false
+	})
+	for i, addr := range addrs {
+		info := infos[i]
+		info.StartHeight = 0
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		if !info.Tombstoned {
+			info.JailedUntil = time.Unix(0, 0)
+		}
+		k.clearMissedArray(ctx, addr)
+		k.SetValidatorSigningInfo(ctx, addr, info)
+	}
+	// clear the jail queue
+	iterator := sdk.KVStorePrefixIterator(store, types.JailedValidatorsQueueKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// returns the addresses of every validator in the unstaking queue
+func (k Keeper) getUnstakingQueue(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakingValidatorsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var validators []sdk.ValAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &validators)
+		valAddrs = append(valAddrs, validators...)
+	}
+	return valAddrs
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestPrepareForZeroHeightGenesis(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(200)
+	var validators []types.Validator
+	for i := int64(1); i <= 3; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(i))
+		keeper.SetValidator(context, validator)
+		keeper.SetValidatorByConsAddr(context, validator)
+		validators = append(validators, validator)
+	}
+	unstaked := validators[2].UpdateStatus(sdk.Unbonded)
+	keeper.SetValidator(context, unstaked)
+
+	releaseTime := time.Now().Add(time.Hour)
+	jailed := types.ValidatorSigningInfo{Address: validators[0].GetConsAddr(), StartHeight: 10, IndexOffset: 150,
+		MissedBlocksCounter: 20, JailedUntil: releaseTime}
+	tombstoned := types.ValidatorSigningInfo{Address: validators[1].GetConsAddr(), StartHeight: 10, IndexOffset: 150,
+		JailedUntil: types.DoubleSignJailEndTime, Tombstoned: true}
+	for _, info := range []types.ValidatorSigningInfo{jailed, tombstoned} {
+		keeper.SetValidatorSigningInfo(context, info.Address, info)
+		keeper.SetMissedBlockArray(context, info.Address, 10, true)
+	}
+	keeper.SetJailedValidator(context, jailed.Address, releaseTime)
+
+	keeper.PrepareForZeroHeightGenesis(context)
+
+	info, found := keeper.GetValidatorSigningInfo(context, jailed.Address)
+	assert.True(t, found)
+	assert.Equal(t, int64(0), info.StartHeight)
+	assert.Equal(t, int64(0), info.IndexOffset)
+	assert.Equal(t, int64(0), info.MissedBlocksCounter)
+	assert.True(t, info.JailedUntil.Equal(time.Unix(0, 0)), "jail term should be ended")
+	info, found = keeper.GetValidatorSigningInfo(context, tombstoned.Address)
+	assert.True(t, found)
+	assert.True(t, info.JailedUntil.Equal(types.DoubleSignJailEndTime), "tombstoned validators stay jailed forever")
+	for _, addr := range []sdk.ConsAddress{jailed.Address, tombstoned.Address} {
+		keeper.IterateAndExecuteOverMissedArray(context, addr, func(index int64, missed bool) (stop bool) {
+			t.Errorf("missed block %d of %s should be cleared", index, addr)
+			return true
+		})
+	}
+	assert.Empty(t, keeper.getJailedValidators(context, releaseTime))
+	// unstaked validators are removed
+	assert.Len(t, keeper.GetAllValidators(context), 2)
+	_, found = keeper.GetValidatorByConsAddr(context, unstaked.ConsAddress())
+	assert.False(t, found)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+)
+
+// get the historical info of the block at height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForHistoricalInfo(height))
+	if value == nil {
+		return hi, false
+	}
+	return types.MustUnmarshalHistoricalInfo(k.cdc, value), true
+}
+
+// set the historical info of the block at height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForHistoricalInfo(height), types.MustMarshalHistoricalInfo(k.cdc, hi))
+}
+
+// delete the historical info of the block at height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForHistoricalInfo(height))
+}
+
+// returns the consensus power of the validator in the validator set of the block at height, from its historical info
+func (k Keeper) powerAtHeight(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) (power int64, found bool) {
+	hi, found := k.GetHistoricalInfo(ctx, height)
+	if !found {
+		return 0, false
+	}
+	for _, validator := range hi.ValSet {
+		if validator.GetConsAddr().Equals(consAddr) {
+			return validator.ConsensusPower(), true
+		}
+	}
+	return 0, false
+}
+
+// called on begin blocker: snapshots the header and the validator set of the current block
+// and prunes the snapshots outside of the HistoricalEntries window
+// NOTE: the validator set is the previous state set, as updated by the end blocker of the previous block
+func (k Keeper) trackHistoricalInfo(ctx sdk.Context) {
+	// bounded by the param validation, so it doesn't overflow
+	entries := int64(k.HistoricalEntries(ctx))
+	// prune every snapshot older than the window, so that lowering HistoricalEntries prunes at once
+	if cutoff := ctx.BlockHeight() - entries + 1; cutoff > 0 {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator(types.HistoricalInfoKey, types.KeyForHistoricalInfo(cutoff))
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	if entries == 0 {
+		return
+	}
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), k.getValsFromPrevState(ctx))
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), hi)
+}
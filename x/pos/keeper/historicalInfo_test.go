@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	for i := int64(1); i <= 2; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(i))
+		keeper.SetValidator(context, validator)
+		keeper.SetPrevStateValPower(context, validator.Address, i)
+	}
+	params := keeper.GetParams(context)
+	params.HistoricalEntries = 5
+	keeper.SetParams(context, params)
+
+	for height := int64(1); height <= 10; height++ {
+		header := abci.Header{Height: height, AppHash: []byte{byte(height)}}
+		keeper.trackHistoricalInfo(context.WithBlockHeader(header))
+		_, found := keeper.GetHistoricalInfo(context, 1)
+		assert.Equal(t, height <= 5, found, "height 1 should be kept until height 6")
+	}
+	// only the last 5 blocks are kept
+	for height := int64(1); height <= 5; height++ {
+		_, found := keeper.GetHistoricalInfo(context, height)
+		assert.False(t, found, "height %d should be pruned", height)
+	}
+	for height := int64(6); height <= 10; height++ {
+		hi, found := keeper.GetHistoricalInfo(context, height)
+		assert.True(t, found, "height %d should be kept", height)
+		assert.Equal(t, height, hi.Header.Height)
+		assert.Equal(t, []byte{byte(height)}, hi.Header.AppHash)
+		assert.Len(t, hi.ValSet, 2)
+	}
+
+	// lowering the window prunes every expired entry at once
+	params.HistoricalEntries = 2
+	keeper.SetParams(context, params)
+	keeper.trackHistoricalInfo(context.WithBlockHeader(abci.Header{Height: 11}))
+	for height := int64(6); height <= 9; height++ {
+		_, found := keeper.GetHistoricalInfo(context, height)
+		assert.False(t, found, "height %d should be pruned", height)
+	}
+	_, found := keeper.GetHistoricalInfo(context, 10)
+	assert.True(t, found)
+	_, found = keeper.GetHistoricalInfo(context, 11)
+	assert.True(t, found)
+
+	// disabling the history prunes everything
+	params.HistoricalEntries = 0
+	keeper.SetParams(context, params)
+	keeper.trackHistoricalInfo(context.WithBlockHeader(abci.Header{Height: 12}))
+	for height := int64(10); height <= 12; height++ {
+		_, found := keeper.GetHistoricalInfo(context, height)
+		assert.False(t, found, "height %d should be pruned", height)
+	}
+}
+
+func TestHistoricalEntriesBound(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	assert.Nil(t, keeper.Paramstore.Update(context, types.KeyHistoricalEntries, []byte(`"100000"`)))
+	// a window that doesn't fit an int64 height is rejected
+	assert.NotNil(t, keeper.Paramstore.Update(context, types.KeyHistoricalEntries, []byte(`"18446744073709551615"`)))
+	assert.Equal(t, types.MaxHistoricalEntries, keeper.HistoricalEntries(context))
+}
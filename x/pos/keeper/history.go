@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"encoding/binary"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+)
+
+// record a slash, jail, unjail or tombstone event for the validator in its current state
+func (k Keeper) recordValidatorHistory(ctx sdk.Context, validator types.Validator, action string, infractionHeight int64,
+	evidenceType string, fraction sdk.Dec, tokensSlashed sdk.Int) {
+	if fraction.IsNil() {
+		fraction = sdk.ZeroDec()
+	}
+	if tokensSlashed == (sdk.Int{}) {
+		tokensSlashed = sdk.ZeroInt()
+	}
+	record := types.ValidatorHistoryRecord{
+		Sequence:         k.nextValidatorHistorySequence(ctx),
+		Address:          validator.Address,
+		Action:           action,
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockHeader().Time,
+		InfractionHeight: infractionHeight,
+		EvidenceType:     evidenceType,
+		Fraction:         fraction,
+		TokensSlashed:    tokensSlashed,
+		Status:           validator.Status,
+		Jailed:           validator.Jailed,
+	}
+	k.SetValidatorHistoryRecord(ctx, record)
+}
+
+// set a history record in the store along with its height index
+func (k Keeper) SetValidatorHistoryRecord(ctx sdk.Context, record types.ValidatorHistoryRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.KeyForValidatorHistory(record.Address, record.Sequence), bz)
+	store.Set(types.KeyForValidatorHistoryByHeight(record.Height, record.Sequence), record.Address)
+}
+
+// returns the next sequence for a history record and increments the counter
+func (k Keeper) nextValidatorHistorySequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	sequence := uint64(0)
+	if bz := store.Get(types.ValidatorHistorySequenceKey); bz != nil {
+		sequence = binary.BigEndian.Uint64(bz)
+	}
+	sequence++
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	store.Set(types.ValidatorHistorySequenceKey, bz)
+	return sequence
+}
+
+// iterate through the history of a validator (newest first), starting below the start sequence (0 for the newest record),
+// and perform the provided function
+func (k Keeper) IterateAndExecuteOverValidatorHistory(ctx sdk.Context, addr sdk.ValAddress, startSequence uint64,
+	handler func(record types.ValidatorHistoryRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyForValidatorHistoryPrefix(addr)
+	end := sdk.PrefixEndBytes(prefix)
+	if startSequence != 0 {
+		end = types.KeyForValidatorHistory(addr, startSequence)
+	}
+	iterator := store.ReverseIterator(prefix, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorHistoryRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// returns up to limit (0 for no limit) slash records of a validator (newest first) older than the start sequence
+// (0 for the newest record)
+func (k Keeper) GetValidatorSlashes(ctx sdk.Context, addr sdk.ValAddress, startSequence uint64, limit int) []types.ValidatorHistoryRecord {
+	return k.getValidatorHistory(ctx, addr, startSequence, 0, limit, func(record types.ValidatorHistoryRecord) bool {
+		return record.Action == types.HistoryActionSlash
+	})
+}
+
+// returns up to limit (0 for no limit) jail, unjail and tombstone records of a validator (newest first) older than the
+// start sequence (0 for the newest record)
+func (k Keeper) GetValidatorJailHistory(ctx sdk.Context, addr sdk.ValAddress, startSequence uint64, limit int) []types.ValidatorHistoryRecord {
+	return k.getValidatorHistory(ctx, addr, startSequence, 0, limit, types.ValidatorHistoryRecord.IsJailRecord)
+}
+
+// returns up to limit (0 for no limit) matching records of a validator (newest first) older than the start sequence,
+// after skipping the first skip matching records; the iteration stops once the limit is reached
+func (k Keeper) getValidatorHistory(ctx sdk.Context, addr sdk.ValAddress, startSequence uint64, skip, limit int,
+	match func(record types.ValidatorHistoryRecord) bool) (records []types.ValidatorHistoryRecord) {
+	k.IterateAndExecuteOverValidatorHistory(ctx, addr, startSequence, func(record types.ValidatorHistoryRecord) (stop bool) {
+		if !match(record) {
+			return false
+		}
+		if skip > 0 {
+			skip--
+			return false
+		}
+		records = append(records, record)
+		return limit > 0 && len(records) >= limit
+	})
+	return
+}
+
+// called on begin blocker: deletes history records older than the retention window
+func (k Keeper) pruneValidatorHistory(ctx sdk.Context) {
+	retention := k.HistoryRetentionBlocks(ctx)
+	if retention <= 0 || ctx.BlockHeight() <= retention {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	// prune everything recorded before the cutoff height
+	cutoff := types.KeyForValidatorHistoryByHeight(ctx.BlockHeight()-retention, 0)
+	iterator := store.Iterator(types.ValidatorHistoryByHeightKey, cutoff)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		sequence := types.SequenceFromValidatorHistoryByHeightKey(iterator.Key())
+		keys = append(keys, types.KeyForValidatorHistory(iterator.Value(), sequence), iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestValidatorHistory(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	validator := types.Validator{Address: sdk.ValAddress([]byte("abcdefghijklmnopqrst")), Status: sdk.Bonded}
+
+	context = context.WithBlockHeight(10)
+	keeper.recordValidatorHistory(context, validator, types.HistoryActionSlash, 9, types.AttributeValueMissingSignature, sdk.NewDecWithPrec(1, 2), sdk.NewInt(5))
+	keeper.recordValidatorHistory(context, validator, types.HistoryActionJail, 9, types.AttributeValueMissingSignature, sdk.ZeroDec(), sdk.ZeroInt())
+	context = context.WithBlockHeight(20)
+	keeper.recordValidatorHistory(context, validator, types.HistoryActionUnjail, 20, "", sdk.ZeroDec(), sdk.ZeroInt())
+
+	slashes := keeper.GetValidatorSlashes(context, validator.Address, 0, 0)
+	assert.Len(t, slashes, 1)
+	assert.Equal(t, sdk.NewInt(5), slashes[0].TokensSlashed)
+	jailHistory := keeper.GetValidatorJailHistory(context, validator.Address, 0, 0)
+	assert.Len(t, jailHistory, 2)
+	assert.Equal(t, types.HistoryActionUnjail, jailHistory[0].Action, "history should be newest first")
+	assert.Equal(t, types.HistoryActionJail, jailHistory[1].Action)
+
+	// page through the jail history from the last record of the previous page
+	firstPage := keeper.GetValidatorJailHistory(context, validator.Address, 0, 1)
+	assert.Equal(t, jailHistory[:1], firstPage)
+	secondPage := keeper.GetValidatorJailHistory(context, validator.Address, firstPage[0].Sequence, 1)
+	assert.Equal(t, jailHistory[1:], secondPage)
+	assert.Empty(t, keeper.GetValidatorJailHistory(context, validator.Address, secondPage[0].Sequence, 1))
+
+	// or by page number
+	var records []types.ValidatorHistoryRecord
+	bz, err := queryJailHistory(context, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryValidatorHistoryParams(validator.Address, 0, 2, 1)),
+	}, keeper)
+	require.Nil(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(bz, &records)
+	assert.Equal(t, jailHistory[1:], records)
+
+	// prune everything recorded before height 15
+	params := keeper.GetParams(context)
+	params.HistoryRetentionBlocks = 10
+	keeper.SetParams(context, params)
+	keeper.pruneValidatorHistory(context.WithBlockHeight(25))
+	assert.Len(t, keeper.GetValidatorSlashes(context, validator.Address, 0, 0), 0)
+	jailHistory = keeper.GetValidatorJailHistory(context, validator.Address, 0, 0)
+	assert.Len(t, jailHistory, 1)
+	assert.Equal(t, types.HistoryActionUnjail, jailHistory[0].Action)
+}
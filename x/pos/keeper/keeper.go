@@ -9,6 +9,7 @@ import (
 	"github.com/pokt-network/posmint/x/params"
 	"github.com/pokt-network/posmint/x/pos/types"
 	"github.com/tendermint/tendermint/libs/log"
+	"sync"
 )
 
 const aminoCacheSize = 500
@@ -26,6 +27,7 @@ type Keeper struct {
 	Paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
+	validatorCacheMu   *sync.Mutex // the txs of a block may be delivered concurrently, see baseapp.DeliverTxs
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -49,6 +51,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, coinKeeper bank.Keeper, suppl
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
+		validatorCacheMu:   &sync.Mutex{},
 		codespace:          codespace,
 	}
 }
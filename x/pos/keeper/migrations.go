@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"bytes"
+	"reflect"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+)
+
+// prefix of the unstaked validators index of version 1, since version 2 the
+// unstaked validators are found by their status among all the validators
+var legacyUnstakedValidatorsKey = []byte{0x42}
+
+// the params added in version 2, missing from a version 1 param store
+var paramsAddedInV2 = [][]byte{
+	types.KeyDowntimeEscalation,
+	types.KeyOffenceDecayPeriod,
+	types.KeyAutoUnjail,
+	types.KeySlashDAOShare,
+	types.KeySlashReporterShare,
+	types.KeyHistoryRetentionBlocks,
+	types.KeyHistoricalEntries,
+}
+
+// MigrateV1ToV2 migrates the pos state from version 1 to 2, deleting the unstaked validators index
+// and setting the params added in version 2 to their defaults, the getters panic on a missing param
+func (k Keeper) MigrateV1ToV2(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, legacyUnstakedValidatorsKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !isParamAddedInV2(pair.Key) || k.Paramstore.Has(ctx, pair.Key) {
+			continue
+		}
+		k.Paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+	return nil
+}
+
+func isParamAddedInV2(key []byte) bool {
+	for _, added := range paramsAddedInV2 {
+		if bytes.Equal(key, added) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestMigrateV1ToV2(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(1))
+	keeper.SetValidator(context, validator)
+
+	store := context.KVStore(keeper.storeKey)
+	legacyKey := append(legacyUnstakedValidatorsKey, validator.Address...)
+	store.Set(legacyKey, []byte{0x01})
+
+	assert.NoError(t, keeper.MigrateV1ToV2(context))
+	assert.False(t, store.Has(legacyKey))
+	_, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+}
+
+func TestMigrateV1ToV2Params(t *testing.T) {
+	context, _, keeper, paramsKeeper := createTestInputWithParamsKeeper(t, true, int64(100), int64(4))
+	// a version 1 param store, without the params added in version 2
+	keeper.Paramstore = paramsKeeper.Subspace("posv1").WithKeyTable(ParamKeyTable())
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !isParamAddedInV2(pair.Key) {
+			keeper.Paramstore.Set(context, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+	assert.Panics(t, func() { keeper.GetParams(context) })
+
+	assert.NoError(t, keeper.MigrateV1ToV2(context))
+	assert.NotPanics(t, func() { keeper.GetParams(context) })
+	assert.True(t, defaults.Equal(keeper.GetParams(context)))
+
+	// a param already set is kept
+	keeper.Paramstore.Set(context, types.KeyAutoUnjail, !defaults.AutoUnjail)
+	assert.NoError(t, keeper.MigrateV1ToV2(context))
+	assert.Equal(t, !defaults.AutoUnjail, keeper.AutoUnjail(context))
+}
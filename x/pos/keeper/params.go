@@ -69,6 +69,12 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) (res int64) {
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64() // todo may have to be int64 .RoundInt64()
 }
 
+// MinSignedPerWindowFraction - the fraction of the window a validator must sign, as stored in the params
+func (k Keeper) MinSignedPerWindowFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.Paramstore.Get(ctx, types.KeyMinSignedPerWindow, &res)
+	return
+}
+
 // Downtime jail duration
 func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
 	k.Paramstore.Get(ctx, types.KeyDowntimeJailDuration, &res)
@@ -87,6 +93,58 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeEscalation - penalty table for repeat downtime offences
+func (k Keeper) DowntimeEscalation(ctx sdk.Context) (res []types.DowntimePenalty) {
+	k.Paramstore.Get(ctx, types.KeyDowntimeEscalation, &res)
+	return
+}
+
+// DowntimePenaltyFor - penalty for the offence count (1 = first offence), see types.Params.DowntimePenaltyFor
+func (k Keeper) DowntimePenaltyFor(ctx sdk.Context, offenceCount int64) types.DowntimePenalty {
+	params := types.Params{DowntimeEscalation: k.DowntimeEscalation(ctx)}
+	if len(params.DowntimeEscalation) == 0 {
+		params.DowntimeJailDuration = k.DowntimeJailDuration(ctx)
+		params.SlashFractionDowntime = k.SlashFractionDowntime(ctx)
+	}
+	return params.DowntimePenaltyFor(offenceCount)
+}
+
+// OffenceDecayPeriod - clean period after which one offence is forgiven
+func (k Keeper) OffenceDecayPeriod(ctx sdk.Context) (res time.Duration) {
+	k.Paramstore.Get(ctx, types.KeyOffenceDecayPeriod, &res)
+	return
+}
+
+// AutoUnjail - whether jailed validators are released automatically
+func (k Keeper) AutoUnjail(ctx sdk.Context) (res bool) {
+	k.Paramstore.Get(ctx, types.KeyAutoUnjail, &res)
+	return
+}
+
+// SlashDAOShare - share of slashed tokens sent to the dao
+func (k Keeper) SlashDAOShare(ctx sdk.Context) (res sdk.Dec) {
+	k.Paramstore.Get(ctx, types.KeySlashDAOShare, &res)
+	return
+}
+
+// SlashReporterShare - share of slashed tokens paid to the evidence reporter
+func (k Keeper) SlashReporterShare(ctx sdk.Context) (res sdk.Dec) {
+	k.Paramstore.Get(ctx, types.KeySlashReporterShare, &res)
+	return
+}
+
+// HistoryRetentionBlocks - number of blocks slash and jail history is kept for
+func (k Keeper) HistoryRetentionBlocks(ctx sdk.Context) (res int64) {
+	k.Paramstore.Get(ctx, types.KeyHistoryRetentionBlocks, &res)
+	return
+}
+
+// HistoricalEntries - number of blocks a header and validator set snapshot is kept for
+func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint64) {
+	k.Paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.Params{
@@ -97,10 +155,17 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		ProposerRewardPercentage: k.ProposerRewardPercentage(ctx),
 		MaxEvidenceAge:           k.MaxEvidenceAge(ctx),
 		SignedBlocksWindow:       k.SignedBlocksWindow(ctx),
-		MinSignedPerWindow:       sdk.NewDec(k.MinSignedPerWindow(ctx)),
+		MinSignedPerWindow:       k.MinSignedPerWindowFraction(ctx),
 		DowntimeJailDuration:     k.DowntimeJailDuration(ctx),
 		SlashFractionDoubleSign:  k.SlashFractionDoubleSign(ctx),
 		SlashFractionDowntime:    k.SlashFractionDowntime(ctx),
+		DowntimeEscalation:       k.DowntimeEscalation(ctx),
+		OffenceDecayPeriod:       k.OffenceDecayPeriod(ctx),
+		AutoUnjail:               k.AutoUnjail(ctx),
+		SlashDAOShare:            k.SlashDAOShare(ctx),
+		SlashReporterShare:       k.SlashReporterShare(ctx),
+		HistoryRetentionBlocks:   k.HistoryRetentionBlocks(ctx),
+		HistoricalEntries:        k.HistoricalEntries(ctx),
 	}
 }
 
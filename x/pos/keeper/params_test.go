@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsRoundTrip(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	params := keeper.GetParams(context)
+	assert.Equal(t, types.DefaultMinSignedPerWindow, params.MinSignedPerWindow)
+	assert.NotPanics(t, func() { keeper.SetParams(context, params) })
+	assert.True(t, params.Equal(keeper.GetParams(context)))
+}
+
+func TestParamsValidation(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+
+	params := keeper.GetParams(context)
+	params.ProposerRewardPercentage = 101
+	assert.Panics(t, func() { keeper.SetParams(context, params) })
+
+	params = keeper.GetParams(context)
+	params.SlashFractionDowntime = sdk.NewDec(-1)
+	assert.Panics(t, func() { keeper.SetParams(context, params) })
+	assert.Equal(t, types.DefaultSlashFractionDowntime, keeper.SlashFractionDowntime(context))
+
+	bz, _ := json.Marshal("-0.5")
+	assert.Error(t, keeper.Paramstore.Update(context, types.KeySlashFractionDowntime, bz))
+	bz, _ = json.Marshal("0.5")
+	assert.NoError(t, keeper.Paramstore.Update(context, types.KeySlashFractionDowntime, bz))
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.SlashFractionDowntime(context))
+}
+
+func TestDowntimePenaltyForDefaults(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	// without an escalation table, updates of the flat downtime params take effect
+	bz, _ := json.Marshal("0.5")
+	assert.NoError(t, keeper.Paramstore.Update(context, types.KeySlashFractionDowntime, bz))
+	penalty := keeper.DowntimePenaltyFor(context, 2)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), penalty.SlashFraction)
+	assert.Equal(t, keeper.DowntimeJailDuration(context), penalty.JailDuration)
+
+	// until the chain opts into one
+	params := keeper.GetParams(context)
+	params.DowntimeEscalation = types.SuggestedDowntimeEscalation()
+	keeper.SetParams(context, params)
+	assert.Equal(t, params.DowntimeEscalation[1], keeper.DowntimePenaltyFor(context, 2))
+}
@@ -66,6 +66,46 @@ func (k Keeper) burnStakedTokens(ctx sdk.Context, amt sdk.Int) sdk.Error {
 	return k.supplyKeeper.BurnCoins(ctx, types.StakedPoolName, coins)
 }
 
+// distributeSlashedTokens splits slashed coins in the staked pool between burning, the dao and the reporter
+func (k Keeper) distributeSlashedTokens(ctx sdk.Context, amt sdk.Int, reporter sdk.ValAddress) (types.SlashDistribution, sdk.Error) {
+	distribution := types.SplitSlashedTokens(amt, k.SlashDAOShare(ctx), k.SlashReporterShare(ctx), reporter != nil)
+	if err := k.burnStakedTokens(ctx, distribution.Burned); err != nil {
+		return distribution, err
+	}
+	if distribution.DAOAllocation.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), distribution.DAOAllocation))
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.StakedPoolName, types.DAOPoolName, coins); err != nil {
+			return distribution, err
+		}
+	}
+	if distribution.ReporterBounty.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), distribution.ReporterBounty))
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, sdk.AccAddress(reporter), coins); err != nil {
+			return distribution, err
+		}
+	}
+	k.SetSlashTotals(ctx, k.GetSlashTotals(ctx).Add(distribution))
+	return distribution, nil
+}
+
+// GetSlashTotals returns the running totals of how slashed tokens were distributed
+func (k Keeper) GetSlashTotals(ctx sdk.Context) types.SlashDistribution {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SlashTotalsKey)
+	if bz == nil {
+		return types.NewSlashDistribution()
+	}
+	var totals types.SlashDistribution
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &totals)
+	return totals
+}
+
+// SetSlashTotals sets the running totals of how slashed tokens were distributed
+func (k Keeper) SetSlashTotals(ctx sdk.Context, totals types.SlashDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SlashTotalsKey, k.cdc.MustMarshalBinaryLengthPrefixed(totals))
+}
+
 func (k Keeper) getFeePool(ctx sdk.Context) (feePool exported.ModuleAccountI) {
 	return k.supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
 }
@@ -9,20 +9,17 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// default page size for validator history queries
+const defaultHistoryQueryLimit = 100
+
 // creates a querier for staking REST endpoints
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
-		case types.QueryValidators:
-			return queryValidators(ctx, req, k)
+		case types.QueryValidators, types.QueryUnstakingValidators, types.QueryStakedValidators, types.QueryUnstakedValidators:
+			return queryValidators(ctx, path[0], req, k)
 		case types.QueryValidator:
 			return queryValidator(ctx, req, k)
-		case types.QueryUnstakingValidators:
-			return queryUnstakingValidators(ctx, req, k)
-		case types.QueryStakedValidators:
-			return queryStakedValidators(ctx, req, k)
-		case types.QueryUnstakedValidators:
-			return queryUnstakedValidators(ctx, req, k)
 		case types.QuerySigningInfo:
 			return querySigningInfo(ctx, req, k)
 		case types.QuerySigningInfos:
@@ -37,29 +34,37 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccountBalance(ctx, req, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
+		case types.QuerySlashTotals:
+			return querySlashTotals(ctx, k)
+		case types.QuerySlashes:
+			return querySlashes(ctx, req, k)
+		case types.QueryJailHistory:
+			return queryJailHistory(ctx, req, k)
+		case types.QueryValidatorUptime:
+			return queryValidatorUptime(ctx, req, k)
+		case types.QueryUptimeTable:
+			return queryUptimeTable(ctx, req, k)
+		case types.QueryHistoricalInfo:
+			return queryHistoricalInfo(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
 	}
 }
 
-func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+func queryValidators(ctx sdk.Context, route string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorsParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
-
-	validators := k.GetAllValidators(ctx)
-
-	start, end := util.Paginate(len(validators), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		validators = []types.Validator{}
-	} else {
-		validators = validators[start:end]
+	if err := params.Validate(); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
 	}
 
+	validators := k.GetValidatorsPage(ctx, route, params)
+
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, validators)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
@@ -86,81 +91,6 @@ func queryAccountBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]by
 	return res, nil
 }
 
-func queryUnstakingValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
-	var params types.QueryUnstakingValidatorsParams
-
-	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
-	if err != nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
-	}
-
-	validators := k.getAllUnstakingValidators(ctx)
-
-	start, end := util.Paginate(len(validators), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		validators = []types.Validator{}
-	} else {
-		validators = validators[start:end]
-	}
-
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, validators)
-	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
-	}
-
-	return res, nil
-}
-
-func queryStakedValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
-	var params types.QueryStakedValidatorsParams
-
-	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
-	if err != nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
-	}
-
-	validators := k.getStakedValidators(ctx)
-
-	start, end := util.Paginate(len(validators), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		validators = []types.Validator{}
-	} else {
-		validators = validators[start:end]
-	}
-
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, validators)
-	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
-	}
-
-	return res, nil
-}
-
-func queryUnstakedValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
-	var params types.QueryValidatorsParams
-
-	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
-	if err != nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
-	}
-
-	validators := k.getAllUnstakedValidators(ctx)
-
-	start, end := util.Paginate(len(validators), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		validators = []types.Validator{}
-	} else {
-		validators = validators[start:end]
-	}
-
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, validators)
-	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
-	}
-
-	return res, nil
-}
-
 func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorParams
 
@@ -232,6 +162,17 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func querySlashTotals(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	totals := k.GetSlashTotals(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, totals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QuerySigningInfoParams
 
@@ -282,3 +223,117 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryValidatorUptime(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorUptimeParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	uptime, found := k.GetValidatorUptime(ctx, params.ConsAddress)
+	if !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, uptime)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryUptimeTable(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryUptimeTableParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	table := k.GetUptimeTable(ctx)
+
+	start, end := util.Paginate(len(table), params.Page, params.Limit, int(k.MaxValidators(ctx)))
+	if start < 0 || end < 0 {
+		table = []types.ValidatorUptime{}
+	} else {
+		table = table[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, table)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHistoricalInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	hi, found := k.GetHistoricalInfo(ctx, params.Height)
+	if !found {
+		return nil, types.ErrNoHistoricalInfo(types.DefaultCodespace, params.Height)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, hi)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySlashes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorHistoryParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	return queryValidatorHistory(ctx, k, params, func(record types.ValidatorHistoryRecord) bool {
+		return record.Action == types.HistoryActionSlash
+	})
+}
+
+func queryJailHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorHistoryParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	return queryValidatorHistory(ctx, k, params, types.ValidatorHistoryRecord.IsJailRecord)
+}
+
+// pages through the matching history records of the validator from the start sequence of the params, without loading
+// the records before the page or after it
+func queryValidatorHistory(ctx sdk.Context, k Keeper, params types.QueryValidatorHistoryParams,
+	match func(record types.ValidatorHistoryRecord) bool) ([]byte, sdk.Error) {
+	records := []types.ValidatorHistoryRecord{}
+	if params.Page > 0 {
+		limit := params.Limit
+		if limit <= 0 {
+			limit = defaultHistoryQueryLimit
+		}
+		if page := k.getValidatorHistory(ctx, params.Address, params.StartSequence, (params.Page-1)*limit, limit, match); page != nil {
+			records = page
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
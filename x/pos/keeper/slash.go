@@ -6,6 +6,7 @@ import (
 	"github.com/pokt-network/posmint/x/pos/types"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"time"
 
 	sdk "github.com/pokt-network/posmint/types"
@@ -19,7 +20,9 @@ func (k Keeper) BurnValidator(ctx sdk.Context, address sdk.ValAddress, severityP
 
 // slash a validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor sdk.Dec) {
+// the reporter (if any) receives a bounty from the slashed tokens, the rest is split between the dao and burning
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor sdk.Dec,
+	evidenceType string, reporter sdk.ValAddress) {
 	// error check slash
 	validator := k.validateSlash(ctx, consAddr, infractionHeight, power, slashFactor)
 	if validator.Address == nil {
@@ -34,12 +37,22 @@ func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 	// Deduct from validator's staked tokens and update the validator.
-	// Burn the slashed tokens from the pool account and decrease the total supply.
+	// Distribute the slashed tokens from the pool account between burning, the dao and the reporter.
 	validator = k.removeValidatorTokens(ctx, validator, tokensToBurn)
-	err := k.burnStakedTokens(ctx, tokensToBurn)
+	distribution, err := k.distributeSlashedTokens(ctx, tokensToBurn, reporter)
 	if err != nil {
 		panic(err)
 	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, distribution.Burned.String()),
+			sdk.NewAttribute(types.AttributeKeyDAOAllocation, distribution.DAOAllocation.String()),
+			sdk.NewAttribute(types.AttributeKeyReporterBounty, distribution.ReporterBounty.String()),
+			sdk.NewAttribute(types.AttributeKeyReporter, reporter.String()),
+		),
+	)
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -47,9 +60,12 @@ func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			panic(err)
 		}
 	}
+	// Record the slash in the validator history
+	validator = k.mustGetValidator(ctx, validator.Address)
+	k.recordValidatorHistory(ctx, validator, types.HistoryActionSlash, infractionHeight, evidenceType, slashFactor, tokensToBurn)
 	// Log that a slash occurred
-	logger.Info(fmt.Sprintf("validator %s slashed by slash factor of %s; burned %v tokens",
-		validator.GetAddress(), slashFactor.String(), tokensToBurn))
+	logger.Info(fmt.Sprintf("validator %s slashed by slash factor of %s; burned %v tokens, %v to dao, %v to reporter",
+		validator.GetAddress(), slashFactor.String(), distribution.Burned, distribution.DAOAllocation, distribution.ReporterBounty))
 	k.AfterValidatorSlashed(ctx, validator.Address, slashFactor)
 }
 
@@ -87,10 +103,11 @@ func (k Keeper) validateSlash(ctx sdk.Context, consAddr sdk.ConsAddress, infract
 
 // handle a validator signing two blocks at the same height
 // power: power of the double-signing validator at the height of infraction
-func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
+// reporter: address of the evidence submitter, nil for evidence provided by Tendermint
+func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64, reporter sdk.ValAddress) sdk.Error {
 	consAddr, signInfo, validator, err := k.validateDoubleSign(ctx, addr, infractionHeight, timestamp)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height.
 	// Note that this *can* result in a negative "distributionHeight", up to -ValidatorUpdateDelay,
@@ -98,6 +115,10 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 
 	// get the percentage slash penalty fraction
 	fraction := k.SlashFractionDoubleSign(ctx)
+	reason := types.AttributeValueDoubleSign
+	if reporter != nil {
+		reason = types.AttributeValueReportedEvidence
+	}
 
 	// slash validator
 	// `power` is the int64 power of the validator as provided to/by Tendermint. This value is validator.StakedTokens as
@@ -107,10 +128,10 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
 		),
 	)
-	k.slash(ctx, consAddr, distributionHeight, power, fraction)
+	k.slash(ctx, consAddr, distributionHeight, power, fraction, reason, reporter)
 
 	// JailValidator validator if not already jailed
 	if !validator.IsJailed() {
@@ -120,7 +141,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 			),
 		)
-		k.JailValidator(ctx, consAddr)
+		k.jailValidator(ctx, consAddr, distributionHeight, reason)
 	}
 	// force the validator to unstake if isn't already
 	v, found := k.GetValidator(ctx, validator.GetAddress())
@@ -137,6 +158,40 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	signInfo.JailedUntil = types.DoubleSignJailEndTime
 	// Set validator signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	// Record the tombstone in the validator history
+	v = k.mustGetValidator(ctx, v.Address)
+	k.recordValidatorHistory(ctx, v, types.HistoryActionTombstone, distributionHeight, reason, fraction, sdk.ZeroInt())
+	return nil
+}
+
+// handle double sign evidence submitted through a transaction, the reporter is paid a bounty from the slash
+// NOTE: the power of the validator at the infraction height is read from the historical info, so evidence older than
+// the HistoricalEntries window is rejected
+func (k Keeper) HandleSubmittedEvidence(ctx sdk.Context, evidence *tmtypes.DuplicateVoteEvidence, reporter sdk.ValAddress) sdk.Error {
+	addr := crypto.Address(evidence.Address())
+	pubKey, er := k.getPubKeyRelation(ctx, addr)
+	if er != nil {
+		return types.ErrInvalidEvidence(k.codespace, er.Error())
+	}
+	if er := evidence.Verify(ctx.ChainID(), pubKey); er != nil {
+		return types.ErrInvalidEvidence(k.codespace, er.Error())
+	}
+	validator, found := k.GetValidatorByConsAddr(ctx, sdk.ConsAddress(addr))
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if validator.Address.Equals(reporter) {
+		return types.ErrInvalidEvidence(k.codespace, "validators cannot report themselves")
+	}
+	if evidence.Height() > ctx.BlockHeight() {
+		return types.ErrInvalidEvidence(k.codespace, fmt.Sprintf("infraction height %d is in the future", evidence.Height()))
+	}
+	// slash the power the validator signed with, not its current one
+	power, found := k.powerAtHeight(ctx, sdk.ConsAddress(addr), evidence.Height())
+	if !found {
+		return types.ErrInvalidEvidence(k.codespace, fmt.Sprintf("power of the validator at height %d is unknown", evidence.Height()))
+	}
+	return k.handleDoubleSign(ctx, addr, evidence.Height(), evidence.VoteA.Timestamp, power, reporter)
 }
 
 func (k Keeper) validateDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time) (consAddr sdk.ConsAddress, signInfo types.ValidatorSigningInfo, validator exported.ValidatorI, err sdk.Error) {
@@ -156,6 +211,7 @@ func (k Keeper) validateDoubleSign(ctx sdk.Context, addr crypto.Address, infract
 	if age > k.MaxEvidenceAge(ctx) {
 		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d",
 			sdk.ConsAddress(pubkey.Address()), infractionHeight, age, k.MaxEvidenceAge(ctx)))
+		err = types.ErrCantHandleEvidence(k.Codespace())
 		return
 	}
 	// Get validator and signing info
@@ -241,6 +297,12 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+			// escalate the penalty based on the (decayed) number of prior offences
+			blockTime := ctx.BlockHeader().Time
+			signInfo.OffenceCount = signInfo.DecayedOffenceCount(blockTime, k.OffenceDecayPeriod(ctx)) + 1
+			signInfo.LastOffenceTime = blockTime
+			penalty := k.DowntimePenaltyFor(ctx, signInfo.OffenceCount)
+			signInfo.JailedUntil = blockTime.Add(penalty.JailDuration)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -248,11 +310,13 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyOffenceCount, fmt.Sprintf("%d", signInfo.OffenceCount)),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.String()),
 				),
 			)
-			k.slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
-			k.JailValidator(ctx, consAddr)
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			k.slash(ctx, consAddr, distributionHeight, power, penalty.SlashFraction, types.AttributeValueMissingSignature, nil)
+			k.jailValidator(ctx, consAddr, distributionHeight, types.AttributeValueMissingSignature)
+			k.SetJailedValidator(ctx, consAddr, signInfo.JailedUntil)
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon restaking.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
@@ -312,7 +376,7 @@ func (k Keeper) burnValidators(ctx sdk.Context) {
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &severity)
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Key(), address)
 		val := k.mustGetValidator(ctx, address)
-		k.slash(ctx, sdk.ConsAddress(address), ctx.BlockHeight(), val.ConsensusPower(), severity)
+		k.slash(ctx, sdk.ConsAddress(address), ctx.BlockHeight(), val.ConsensusPower(), severity, types.AttributeValueBurn, nil)
 		// remove from the burn store
 		store.Delete(iterator.Key())
 	}
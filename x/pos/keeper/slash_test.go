@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// stakes a new validator with freshly minted tokens
+func stakeTestValidator(t *testing.T, ctx sdk.Context, keeper Keeper, power int64) (types.Validator, crypto.PrivKey) {
+	privKey := ed25519.GenPrivKey()
+	pubKey := privKey.PubKey()
+	tokens := sdk.TokensFromConsensusPower(power)
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), tokens))
+	require.Nil(t, keeper.supplyKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.Nil(t, keeper.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.StakedPoolName, coins))
+	validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, tokens)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	keeper.SetStakedValidator(ctx, validator)
+	keeper.AddPubKeyRelation(ctx, pubKey)
+	keeper.SetValidatorSigningInfo(ctx, validator.GetConsAddr(),
+		types.ValidatorSigningInfo{Address: validator.GetConsAddr(), StartHeight: ctx.BlockHeight(), JailedUntil: time.Unix(0, 0)})
+	return validator, privKey
+}
+
+// signs two conflicting prevotes at the height
+func duplicateVoteEvidence(t *testing.T, ctx sdk.Context, privKey crypto.PrivKey, height int64) *tmtypes.DuplicateVoteEvidence {
+	votes := make([]*tmtypes.Vote, 2)
+	for i, block := range []string{"a", "b"} {
+		vote := &tmtypes.Vote{
+			Type:      tmtypes.PrevoteType,
+			Height:    height,
+			Timestamp: ctx.BlockTime(),
+			BlockID: tmtypes.BlockID{
+				Hash:        tmhash.Sum([]byte(block)),
+				PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block))},
+			},
+			ValidatorAddress: privKey.PubKey().Address(),
+		}
+		sig, err := privKey.Sign(vote.SignBytes(ctx.ChainID()))
+		require.NoError(t, err)
+		vote.Signature = sig
+		votes[i] = vote
+	}
+	return &tmtypes.DuplicateVoteEvidence{PubKey: privKey.PubKey(), VoteA: votes[0], VoteB: votes[1]}
+}
+
+func TestSlashDistribution(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(1)
+	params := keeper.GetParams(context)
+	params.SlashDAOShare = sdk.NewDecWithPrec(2, 1)
+	params.SlashReporterShare = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(context, params)
+	validator, _ := stakeTestValidator(t, context, keeper, 100)
+	reporter := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	supplyBefore := keeper.TotalTokens(context)
+
+	// slash 10% of the stake: 20% to the dao, 10% to the reporter and the rest burned
+	keeper.slash(context, validator.GetConsAddr(), 1, 100, sdk.NewDecWithPrec(1, 1), types.AttributeValueReportedEvidence, reporter)
+	expected := types.SlashDistribution{
+		Burned:         sdk.TokensFromConsensusPower(7),
+		DAOAllocation:  sdk.TokensFromConsensusPower(2),
+		ReporterBounty: sdk.TokensFromConsensusPower(1),
+	}
+	assert.Equal(t, expected, keeper.GetSlashTotals(context))
+	assert.Equal(t, expected.DAOAllocation, keeper.GetDAOPool(context).GetCoins().AmountOf(keeper.StakeDenom(context)))
+	assert.Equal(t, expected.ReporterBounty, keeper.coinKeeper.GetCoins(context, sdk.AccAddress(reporter)).AmountOf(keeper.StakeDenom(context)))
+	assert.Equal(t, supplyBefore.Sub(expected.Burned), keeper.TotalTokens(context))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.Equal(t, sdk.TokensFromConsensusPower(90), validator.StakedTokens)
+
+	// the remaining stake of a validator slashed below the minimum is distributed too, without a bounty
+	params.StakeMinimum = sdk.TokensFromConsensusPower(85).Int64()
+	keeper.SetParams(context, params)
+	keeper.slash(context, validator.GetConsAddr(), 1, 90, sdk.NewDecWithPrec(1, 1), types.AttributeValueMissingSignature, nil)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.IsZero())
+	assert.True(t, validator.IsUnstaked())
+	totals := keeper.GetSlashTotals(context)
+	assert.Equal(t, sdk.TokensFromConsensusPower(100), totals.Total())
+	assert.Equal(t, expected.ReporterBounty, totals.ReporterBounty)
+	assert.Equal(t, sdk.TokensFromConsensusPower(20), totals.DAOAllocation)
+	assert.Equal(t, supplyBefore.Sub(totals.Burned), keeper.TotalTokens(context))
+}
+
+func TestSlashSharesAboveOne(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(1)
+	// each share is valid on its own, but they sum to more than one
+	require.Nil(t, keeper.Paramstore.Update(context, types.KeySlashDAOShare, []byte(`"0.800000000000000000"`)))
+	require.Nil(t, keeper.Paramstore.Update(context, types.KeySlashReporterShare, []byte(`"0.800000000000000000"`)))
+	validator, _ := stakeTestValidator(t, context, keeper, 100)
+	reporter := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	assert.NotPanics(t, func() {
+		keeper.slash(context, validator.GetConsAddr(), 1, 100, sdk.NewDecWithPrec(1, 1), types.AttributeValueReportedEvidence, reporter)
+	})
+	expected := types.SlashDistribution{
+		Burned:         sdk.ZeroInt(),
+		DAOAllocation:  sdk.TokensFromConsensusPower(8),
+		ReporterBounty: sdk.TokensFromConsensusPower(2),
+	}
+	assert.Equal(t, expected, keeper.GetSlashTotals(context))
+}
+
+func TestSubmittedEvidenceThenBeginBlock(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0).UTC())
+	validator, privKey := stakeTestValidator(t, context, keeper, 100)
+	keeper.SetHistoricalInfo(context, 1, types.NewHistoricalInfo(context.BlockHeader(), []types.Validator{validator}))
+	reporter := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	msg := types.MsgSubmitEvidence{Reporter: reporter, Evidence: duplicateVoteEvidence(t, context, privKey, 1)}
+	require.Nil(t, msg.ValidateBasic())
+
+	require.Nil(t, keeper.HandleSubmittedEvidence(context, msg.Evidence, msg.Reporter))
+	info, _ := keeper.GetValidatorSigningInfo(context, validator.GetConsAddr())
+	assert.True(t, info.Tombstoned)
+	totals := keeper.GetSlashTotals(context)
+	assert.True(t, totals.ReporterBounty.IsPositive())
+	assert.Equal(t, sdk.TokensFromConsensusPower(100), totals.Total())
+
+	// the same evidence delivered by tendermint is skipped instead of halting the chain
+	req := abci.RequestBeginBlock{
+		Header: context.BlockHeader(),
+		ByzantineValidators: []abci.Evidence{{
+			Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+			Validator: abci.Validator{Address: validator.GetConsAddr(), Power: 100},
+			Height:    1,
+			Time:      context.BlockTime(),
+		}},
+	}
+	assert.NotPanics(t, func() { BeginBlocker(context, req, keeper) })
+	assert.Equal(t, totals, keeper.GetSlashTotals(context))
+	// and so is evidence of an unknown validator
+	req.ByzantineValidators[0].Validator.Address = ed25519.GenPrivKey().PubKey().Address()
+	assert.NotPanics(t, func() { BeginBlocker(context, req, keeper) })
+}
+
+func TestSubmittedEvidencePower(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(2).WithBlockTime(time.Unix(1000, 0).UTC())
+	validator, privKey := stakeTestValidator(t, context, keeper, 100)
+	reporter := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// evidence of a future height is rejected
+	assert.NotNil(t, keeper.HandleSubmittedEvidence(context, duplicateVoteEvidence(t, context, privKey, 3), reporter))
+	// and so is evidence of a height whose validator set is unknown
+	assert.NotNil(t, keeper.HandleSubmittedEvidence(context, duplicateVoteEvidence(t, context, privKey, 1), reporter))
+
+	// the validator had half of its current stake when it double signed
+	signingValidator := validator
+	signingValidator.StakedTokens = sdk.TokensFromConsensusPower(50)
+	keeper.SetHistoricalInfo(context, 1, types.NewHistoricalInfo(context.BlockHeader(), []types.Validator{signingValidator}))
+	require.Nil(t, keeper.HandleSubmittedEvidence(context, duplicateVoteEvidence(t, context, privKey, 1), reporter))
+	slashes := keeper.GetValidatorSlashes(context, validator.Address, 0, 1)
+	require.Len(t, slashes, 1)
+	expected := sdk.TokensFromConsensusPower(50).ToDec().Mul(keeper.SlashFractionDoubleSign(context)).TruncateInt()
+	assert.Equal(t, expected, slashes[0].TokensSlashed)
+}
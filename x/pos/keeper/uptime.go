@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"bytes"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"sort"
+)
+
+// returns the uptime of a validator over the current signed blocks window along with its missed block bitmap
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, consAddr sdk.ConsAddress) (uptime types.ValidatorUptime, found bool) {
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return
+	}
+	uptime = k.newValidatorUptime(ctx, info)
+	// the missed block array is circular, so walk it from the oldest counted index
+	window := uptime.SignedBlocksWindow
+	start := int64(0)
+	if info.IndexOffset > window {
+		start = info.IndexOffset % window
+	}
+	uptime.MissedBlocks = make([]bool, uptime.BlocksCounted)
+	for i := int64(0); i < uptime.BlocksCounted; i++ {
+		uptime.MissedBlocks[i] = k.getMissedBlockArray(ctx, consAddr, (start+i)%window)
+	}
+	return uptime, true
+}
+
+// returns the uptime of every staked validator ranked lowest uptime first (without the missed block bitmaps)
+func (k Keeper) GetUptimeTable(ctx sdk.Context) (table []types.ValidatorUptime) {
+	table = []types.ValidatorUptime{}
+	k.iterateStakedValidatorsByPower(ctx, func(validator types.Validator) (stop bool) {
+		info, found := k.GetValidatorSigningInfo(ctx, validator.GetConsAddr())
+		if !found {
+			return false
+		}
+		table = append(table, k.newValidatorUptime(ctx, info))
+		return false
+	})
+	sort.SliceStable(table, func(i, j int) bool {
+		if !table[i].Uptime.Equal(table[j].Uptime) {
+			return table[i].Uptime.LT(table[j].Uptime)
+		}
+		return bytes.Compare(table[i].Address, table[j].Address) == -1
+	})
+	return
+}
+
+func (k Keeper) newValidatorUptime(ctx sdk.Context, info types.ValidatorSigningInfo) types.ValidatorUptime {
+	window := k.SignedBlocksWindow(ctx)
+	maxMissed := window - k.MinSignedPerWindow(ctx)
+	return types.NewValidatorUptime(info, window, maxMissed, ctx.BlockHeight())
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestGetValidatorUptime(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	context = context.WithBlockHeight(200)
+	var validators []types.Validator
+	for i := int64(1); i <= 3; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(i))
+		keeper.SetValidator(context, validator)
+		keeper.SetStakedValidator(context, validator)
+		validators = append(validators, validator)
+	}
+	// window of 100 blocks with 50 allowed misses (default params)
+	healthy := types.ValidatorSigningInfo{Address: validators[0].GetConsAddr(), IndexOffset: 120, MissedBlocksCounter: 1}
+	failing := types.ValidatorSigningInfo{Address: validators[1].GetConsAddr(), IndexOffset: 40, MissedBlocksCounter: 30}
+	unseen := types.ValidatorSigningInfo{Address: validators[2].GetConsAddr()}
+	for _, info := range []types.ValidatorSigningInfo{healthy, failing, unseen} {
+		keeper.SetValidatorSigningInfo(context, info.Address, info)
+	}
+	// the oldest block of a full window is at index offset % window
+	keeper.SetMissedBlockArray(context, healthy.Address, 20, true)
+
+	uptime, found := keeper.GetValidatorUptime(context, healthy.Address)
+	assert.True(t, found)
+	assert.Equal(t, int64(100), uptime.BlocksCounted)
+	assert.Len(t, uptime.MissedBlocks, 100)
+	assert.True(t, uptime.MissedBlocks[0])
+	assert.False(t, uptime.MissedBlocks[1])
+	assert.Equal(t, sdk.NewDecWithPrec(99, 2), uptime.Uptime)
+	assert.Equal(t, int64(0), uptime.ProjectedJailHeight, "healthy validator should not be projected to be jailed")
+
+	uptime, found = keeper.GetValidatorUptime(context, failing.Address)
+	assert.True(t, found)
+	assert.Len(t, uptime.MissedBlocks, 40)
+	assert.Equal(t, sdk.NewDecWithPrec(25, 2), uptime.Uptime)
+	// 21 more misses at a rate of 3 in 4 takes 28 blocks
+	assert.Equal(t, int64(228), uptime.ProjectedJailHeight)
+
+	_, found = keeper.GetValidatorUptime(context, sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()))
+	assert.False(t, found)
+
+	table := keeper.GetUptimeTable(context)
+	assert.Len(t, table, 3)
+	assert.Equal(t, failing.Address, table[0].Address, "lowest uptime should be ranked first")
+	assert.Equal(t, healthy.Address, table[1].Address)
+	assert.Equal(t, unseen.Address, table[2].Address)
+	assert.Nil(t, table[0].MissedBlocks)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"time"
+)
+
+// Insert a validator consensus address into the jail queue at its release time
+func (k Keeper) SetJailedValidator(ctx sdk.Context, consAddr sdk.ConsAddress, releaseTime time.Time) {
+	addrs := k.getJailedValidators(ctx, releaseTime)
+	addrs = append(addrs, consAddr)
+	k.setJailedValidators(ctx, releaseTime, addrs)
+}
+
+// Delete a validator consensus address from the jail queue
+func (k Keeper) deleteJailedValidator(ctx sdk.Context, consAddr sdk.ConsAddress, releaseTime time.Time) {
+	addrs := k.getJailedValidators(ctx, releaseTime)
+	var newAddrs []sdk.ConsAddress
+	for _, addr := range addrs {
+		if !bytes.Equal(addr, consAddr) {
+			newAddrs = append(newAddrs, addr)
+		}
+	}
+	if len(newAddrs) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.KeyForJailedValidatorsQueue(releaseTime))
+	} else {
+		k.setJailedValidators(ctx, releaseTime, newAddrs)
+	}
+}
+
+// gets all of the validators who will be released at exactly this time
+func (k Keeper) getJailedValidators(ctx sdk.Context, releaseTime time.Time) (consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForJailedValidatorsQueue(releaseTime))
+	if bz == nil {
+		return []sdk.ConsAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &consAddrs)
+	return consAddrs
+}
+
+// sets validators in the jail queue at a certain release time
+func (k Keeper) setJailedValidators(ctx sdk.Context, releaseTime time.Time, consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(consAddrs)
+	store.Set(types.KeyForJailedValidatorsQueue(releaseTime), bz)
+}
+
+// iterator for all jailed validators up to a certain time
+func (k Keeper) jailedValidatorsIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.JailedValidatorsQueueKey, sdk.InclusiveEndBytes(types.KeyForJailedValidatorsQueue(endTime)))
+}
+
+// called on begin blocker: unjails every validator whose jail term has expired
+// NOTE: a validator that can't be released yet (e.g. its stake is below the minimum) stays in the queue and is retried
+// every block, the others are removed from it
+func (k Keeper) autoUnjailValidators(ctx sdk.Context) {
+	if !k.AutoUnjail(ctx) {
+		return
+	}
+	logger := k.Logger(ctx)
+	store := ctx.KVStore(k.storeKey)
+	var (
+		keys      [][]byte
+		remaining [][]sdk.ConsAddress
+	)
+	iterator := k.jailedValidatorsIterator(ctx, ctx.BlockHeader().Time)
+	for ; iterator.Valid(); iterator.Next() {
+		var consAddrs []sdk.ConsAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &consAddrs)
+		var retry []sdk.ConsAddress
+		for _, consAddr := range consAddrs {
+			if err := k.ValidateAutoUnjail(ctx, consAddr); err != nil {
+				logger.Info(fmt.Sprintf("validator %s not automatically unjailed: %s", consAddr, err.Error()))
+				if k.mayBeAutoUnjailed(ctx, consAddr) {
+					retry = append(retry, consAddr)
+				}
+				continue
+			}
+			k.unjailValidator(ctx, consAddr, types.EventTypeAutoUnjail)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoUnjail,
+					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				),
+			)
+		}
+		keys = append(keys, iterator.Key())
+		remaining = append(remaining, retry)
+	}
+	iterator.Close()
+	for i, key := range keys {
+		if len(remaining[i]) == 0 {
+			store.Delete(key)
+		} else {
+			store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(remaining[i]))
+		}
+	}
+}
+
+// returns true if a validator that failed the auto unjail check is still jailed and may pass it later
+func (k Keeper) mayBeAutoUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found || !validator.IsJailed() || !validator.IsStaked() {
+		return false
+	}
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	return found && !info.Tombstoned
+}
+
+// validate check called before automatically releasing a validator from jail
+func (k Keeper) ValidateAutoUnjail(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.Error {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if !validator.IsJailed() {
+		return types.ErrValidatorNotJailed(k.codespace)
+	}
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrSelfDelegationTooLowToUnjail(k.codespace)
+	}
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return types.ErrNoSigningInfoFound(k.codespace, consAddr)
+	}
+	if info.Tombstoned {
+		return types.ErrValidatorTombstoned(k.codespace)
+	}
+	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestJailQueue(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	keeper.Paramstore.Set(context, types.KeyAutoUnjail, true)
+	releaseTime := time.Unix(1000, 0).UTC()
+	var validators []types.Validator
+	for _, tokens := range []sdk.Int{sdk.TokensFromConsensusPower(1), sdk.ZeroInt()} {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, tokens)
+		validator.Jailed = true
+		keeper.SetValidator(context, validator)
+		keeper.SetValidatorByConsAddr(context, validator)
+		keeper.SetValidatorSigningInfo(context, validator.GetConsAddr(),
+			types.ValidatorSigningInfo{Address: validator.GetConsAddr(), JailedUntil: releaseTime})
+		keeper.SetJailedValidator(context, validator.GetConsAddr(), releaseTime)
+		validators = append(validators, validator)
+	}
+	manual, lowStake := validators[0], validators[1]
+
+	// a manual unjail removes the validator from the queue
+	keeper.UnjailValidator(context, manual.GetConsAddr())
+	assert.Equal(t, []sdk.ConsAddress{lowStake.GetConsAddr()}, keeper.getJailedValidators(context, releaseTime))
+
+	// a validator that fails the auto unjail check is kept in the queue and retried
+	context = context.WithBlockTime(releaseTime.Add(time.Second))
+	keeper.autoUnjailValidators(context)
+	validator, _ := keeper.GetValidator(context, lowStake.Address)
+	assert.True(t, validator.IsJailed())
+	assert.Equal(t, []sdk.ConsAddress{lowStake.GetConsAddr()}, keeper.getJailedValidators(context, releaseTime))
+
+	validator.StakedTokens = sdk.TokensFromConsensusPower(1)
+	keeper.SetValidator(context, validator)
+	keeper.autoUnjailValidators(context)
+	validator, _ = keeper.GetValidator(context, lowStake.Address)
+	assert.False(t, validator.IsJailed())
+	assert.Empty(t, keeper.getJailedValidators(context, releaseTime))
+}
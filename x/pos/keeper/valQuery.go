@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"sort"
+)
+
+// returns a page of the validators from the query route that match the params, along with the total number of matches
+func (k Keeper) GetValidatorsPage(ctx sdk.Context, route string, params types.QueryValidatorsParams) types.QueryValidatorsResponse {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = types.DefaultValidatorsQueryLimit
+	} else if limit > types.MaxValidatorsQueryLimit {
+		limit = types.MaxValidatorsQueryLimit
+	}
+	res := types.QueryValidatorsResponse{Validators: types.Validators{}, Page: params.Page, Limit: limit}
+	if params.Page < 1 {
+		return res // invalid start page
+	}
+	start, end := (params.Page-1)*limit, params.Page*limit
+	iterate := k.validatorIteratorForRoute(route)
+	matches := func(validator types.Validator) bool {
+		if route == types.QueryUnstakedValidators && !validator.IsUnstaked() {
+			return false
+		}
+		return params.Matches(validator)
+	}
+	// the power index is already sorted by stake, so the page can be streamed without sorting
+	if params.SortBy == "" || (params.SortBy == types.SortByStake && route == types.QueryStakedValidators) {
+		iterate(ctx, func(validator types.Validator) (stop bool) {
+			if params.HasMinStake() && route == types.QueryStakedValidators &&
+				validator.ConsensusPower() < sdk.TokensToConsensusPower(params.MinStake) {
+				return true // every following validator has less power
+			}
+			if !matches(validator) {
+				return false
+			}
+			if res.Total >= start && res.Total < end {
+				res.Validators = append(res.Validators, validator)
+			}
+			res.Total++
+			return params.SkipTotal && res.Total >= end
+		})
+		if params.SkipTotal {
+			res.Total = -1
+		}
+		return res
+	}
+	// otherwise collect every match and sort
+	var validators types.Validators
+	iterate(ctx, func(validator types.Validator) (stop bool) {
+		if matches(validator) {
+			validators = append(validators, validator)
+		}
+		return false
+	})
+	switch params.SortBy {
+	case types.SortByStake:
+		sort.SliceStable(validators, func(i, j int) bool {
+			return validators[i].StakedTokens.GT(validators[j].StakedTokens)
+		})
+	case types.SortByAddress:
+		sort.SliceStable(validators, func(i, j int) bool {
+			return bytes.Compare(validators[i].Address, validators[j].Address) == -1
+		})
+	}
+	res.Total = len(validators)
+	if params.SkipTotal {
+		res.Total = -1
+	}
+	if start < len(validators) {
+		if end > len(validators) {
+			end = len(validators)
+		}
+		res.Validators = validators[start:end]
+	}
+	return res
+}
+
+type validatorIterator func(ctx sdk.Context, fn func(validator types.Validator) (stop bool))
+
+// returns the cheapest index to iterate for the query route
+func (k Keeper) validatorIteratorForRoute(route string) validatorIterator {
+	switch route {
+	case types.QueryStakedValidators:
+		return k.iterateStakedValidatorsByPower
+	case types.QueryUnstakingValidators:
+		return k.iterateUnstakingValidatorsQueue
+	default:
+		return k.iterateAllValidators
+	}
+}
+
+// iterate through the main validator store
+func (k Keeper) iterateAllValidators(ctx sdk.Context, fn func(validator types.Validator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AllValidatorsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(types.MustUnmarshalValidator(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// iterate through the staked validators highest power first
+func (k Keeper) iterateStakedValidatorsByPower(ctx sdk.Context, fn func(validator types.Validator) (stop bool)) {
+	iterator := k.stakedValsIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		if !validator.IsStaked() {
+			continue
+		}
+		if fn(validator) {
+			break
+		}
+	}
+}
+
+// iterate through the unstaking queue soonest completion time first
+func (k Keeper) iterateUnstakingValidatorsQueue(ctx sdk.Context, fn func(validator types.Validator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakingValidatorsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var valAddrs []sdk.ValAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &valAddrs)
+		for _, valAddr := range valAddrs {
+			if fn(k.mustGetValidator(ctx, valAddr)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestGetValidatorsPage(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	var validators []types.Validator
+	for i := int64(1); i <= 5; i++ {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := types.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, sdk.TokensFromConsensusPower(i))
+		keeper.SetValidator(context, validator)
+		keeper.SetStakedValidator(context, validator)
+		validators = append(validators, validator)
+	}
+	unstaked := validators[0].UpdateStatus(sdk.Unbonded)
+	keeper.deleteValidatorFromStakingSet(context, validators[0])
+	keeper.SetValidator(context, unstaked)
+	jailed := true
+
+	tests := []struct {
+		name      string
+		route     string
+		params    types.QueryValidatorsParams
+		wantTotal int
+		want      []types.Validator
+	}{
+		{"staked validators are ordered by stake", types.QueryStakedValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 2}, 4, []types.Validator{validators[4], validators[3]}},
+		{"second page of staked validators", types.QueryStakedValidators,
+			types.QueryValidatorsParams{Page: 2, Limit: 2}, 4, []types.Validator{validators[2], validators[1]}},
+		{"minimum stake filter", types.QueryStakedValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 10, MinStake: sdk.TokensFromConsensusPower(4)}, 2, []types.Validator{validators[4], validators[3]}},
+		{"unstaked validators", types.QueryUnstakedValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 10}, 1, []types.Validator{unstaked}},
+		{"all validators sorted by stake", types.QueryValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 1, SortBy: types.SortByStake}, 5, []types.Validator{validators[4]}},
+		{"jailed filter", types.QueryValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 10, Jailed: &jailed}, 0, []types.Validator{}},
+		{"top staked validators without the total", types.QueryStakedValidators,
+			types.QueryValidatorsParams{Page: 1, Limit: 2, SkipTotal: true}, -1, []types.Validator{validators[4], validators[3]}},
+		{"page out of bounds", types.QueryValidators,
+			types.QueryValidatorsParams{Page: 3, Limit: 5}, 5, []types.Validator{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := keeper.GetValidatorsPage(context, test.route, test.params)
+			assert.Equal(t, test.wantTotal, res.Total, "totals do not match")
+			assert.Len(t, res.Validators, len(test.want))
+			for i, validator := range test.want {
+				assert.Equal(t, validator.Address, res.Validators[i].Address, "validators do not match")
+			}
+		})
+	}
+}
+
+func TestGetValidatorsPageLimit(t *testing.T) {
+	context, _, keeper := createTestInput(t, true, int64(100), int64(4))
+	res := keeper.GetValidatorsPage(context, types.QueryValidators, types.QueryValidatorsParams{Page: 1})
+	assert.Equal(t, types.DefaultValidatorsQueryLimit, res.Limit)
+	res = keeper.GetValidatorsPage(context, types.QueryValidators, types.QueryValidatorsParams{Page: 1, Limit: 1 << 20})
+	assert.Equal(t, types.MaxValidatorsQueryLimit, res.Limit)
+}
@@ -204,14 +204,14 @@ func (k Keeper) FinishUnstakingValidator(ctx sdk.Context, validator types.Valida
 	return nil
 }
 
-// force unstake (called when slashed below the minimum)
+// force unstake (called when slashed below the minimum or tombstoned), the remaining stake is distributed as slashed tokens
 func (k Keeper) ForceValidatorUnstake(ctx sdk.Context, validator types.Validator) sdk.Error {
 	// call the before unstaked hook
 	k.BeforeValidatorUnstaked(ctx, validator.ConsAddress(), validator.Address)
 	// delete the validator from staking set as they are unstaked
 	k.deleteValidatorFromStakingSet(ctx, validator)
-	// amount unstaked = stakedTokens
-	err := k.burnStakedTokens(ctx, validator.StakedTokens)
+	// amount unstaked = stakedTokens, slashed like any other penalty (without a reporter bounty)
+	_, err := k.distributeSlashedTokens(ctx, validator.StakedTokens, nil)
 	if err != nil {
 		return err
 	}
@@ -241,6 +241,11 @@ func (k Keeper) ForceValidatorUnstake(ctx sdk.Context, validator types.Validator
 
 // send a validator to jail
 func (k Keeper) JailValidator(ctx sdk.Context, addr sdk.ConsAddress) {
+	k.jailValidator(ctx, addr, ctx.BlockHeight(), "")
+}
+
+// send a validator to jail for an infraction and record it in the validator history
+func (k Keeper) jailValidator(ctx sdk.Context, addr sdk.ConsAddress, infractionHeight int64, evidenceType string) {
 	validator := k.mustGetValidatorByConsAddr(ctx, addr)
 	if validator.Jailed {
 		panic(fmt.Sprintf("cannot jail already jailed validator, validator: %v\n", validator))
@@ -248,12 +253,21 @@ func (k Keeper) JailValidator(ctx sdk.Context, addr sdk.ConsAddress) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.deleteValidatorFromStakingSet(ctx, validator)
+	k.recordValidatorHistory(ctx, validator, types.HistoryActionJail, infractionHeight, evidenceType, sdk.ZeroDec(), sdk.ZeroInt())
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("validator %s jailed", addr))
 }
 
-// remove a validator from jail
+// remove a validator from jail, and from the jail queue so it isn't released again
 func (k Keeper) UnjailValidator(ctx sdk.Context, addr sdk.ConsAddress) {
+	if info, found := k.GetValidatorSigningInfo(ctx, addr); found {
+		k.deleteJailedValidator(ctx, addr, info.JailedUntil)
+	}
+	k.unjailValidator(ctx, addr, "")
+}
+
+// remove a validator from jail and record it in the validator history
+func (k Keeper) unjailValidator(ctx sdk.Context, addr sdk.ConsAddress, reason string) {
 	validator := k.mustGetValidatorByConsAddr(ctx, addr)
 	if !validator.Jailed {
 		panic(fmt.Sprintf("cannot unjail already unjailed validator, validator: %v\n", validator))
@@ -261,6 +275,7 @@ func (k Keeper) UnjailValidator(ctx sdk.Context, addr sdk.ConsAddress) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetStakedValidator(ctx, validator)
+	k.recordValidatorHistory(ctx, validator, types.HistoryActionUnjail, ctx.BlockHeight(), reason, sdk.ZeroDec(), sdk.ZeroInt())
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("validator %s unjailed", addr))
 }
@@ -26,6 +26,8 @@ func newCachedValidator(val types.Validator, marshalled string) cachedValidator
 }
 
 func (k Keeper) validatorCaching(value []byte, addr sdk.ValAddress) types.Validator {
+	k.validatorCacheMu.Lock()
+	defer k.validatorCacheMu.Unlock()
 	// If these amino encoded bytes are in the cache, return the cached validator
 	strValue := string(value)
 	if val, ok := k.validatorCache[strValue]; ok {
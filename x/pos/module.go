@@ -2,9 +2,7 @@ package pos
 
 import (
 	"encoding/json"
-	"github.com/pokt-network/posmint/crypto/keys"
 	"github.com/pokt-network/posmint/x/pos/keeper"
-	"github.com/tendermint/tendermint/node"
 
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
@@ -14,8 +12,10 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.HasMigrations        = AppModule{}
+	_ module.StoreDecoderProvider = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the staking module.
@@ -52,22 +52,18 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 // AppModule implements an application module for the staking module.
 type AppModule struct {
 	AppModuleBasic
-	keybase       keys.Keybase
-	node          *node.Node
 	keeper        keeper.Keeper
 	accountKeeper types.AccountKeeper
 	supplyKeeper  types.SupplyKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper keeper.Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper, node *node.Node, keybase keys.Keybase) AppModule {
+func NewAppModule(keeper keeper.Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
 		supplyKeeper:   supplyKeeper,
-		node:           node,
-		keybase:        keybase,
 	}
 }
 
@@ -81,14 +77,6 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
-func (am AppModule) GetTendermintNode() *node.Node {
-	return am.node
-}
-
-func (am AppModule) GetKeybase() keys.Keybase {
-	return am.keybase
-}
-
 // Route returns the message routing key for the staking module.
 func (AppModule) Route() string {
 	return types.RouterKey
@@ -109,6 +97,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return keeper.NewQuerier(am.keeper)
 }
 
+// StoreDecoders decodes the validators and signing infos of the staking store.
+func (am AppModule) StoreDecoders() map[string]sdk.StoreDecoder {
+	return map[string]sdk.StoreDecoder{types.StoreKey: am.keeper.DecodeStore}
+}
+
 // InitGenesis performs genesis initialization for the pos module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -124,6 +117,23 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
+// PrepareForZeroHeightGenesis adjusts the state of the pos module before an
+// export relaunching a new chain from height zero.
+func (am AppModule) PrepareForZeroHeightGenesis(ctx sdk.Context) {
+	am.keeper.PrepareForZeroHeightGenesis(ctx)
+}
+
+// ConsensusVersion returns the version of the pos module state, 2 since the unstaked
+// validators index was removed.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// Migrations returns the migrations of the pos module state, by version migrated from.
+func (am AppModule) Migrations() map[uint64]module.MigrationHandler {
+	return map[uint64]module.MigrationHandler{
+		1: am.keeper.MigrateV1ToV2,
+	}
+}
+
 // module begin-block
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	keeper.BeginBlocker(ctx, req, am.keeper)
@@ -7,12 +7,11 @@ import (
 	"github.com/pokt-network/posmint/x/auth/util"
 	"github.com/pokt-network/posmint/x/pos/types"
 
-	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
-func (am AppModule) QueryAccountBalance(cdc *codec.Codec, addr sdk.ValAddress, height int64) (sdk.Int, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QueryAccountBalance(clientCtx util.ClientContext, cdc *codec.Codec, addr sdk.ValAddress, height int64) (sdk.Int, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	params := types.QueryAccountBalanceParams{ValAddress: addr}
 	bz, err := cdc.MarshalBinaryBare(params)
 	if err != nil {
@@ -27,8 +26,8 @@ func (am AppModule) QueryAccountBalance(cdc *codec.Codec, addr sdk.ValAddress, h
 	return balance, nil
 }
 
-func (am AppModule) QueryValidator(cdc *codec.Codec, addr sdk.ValAddress, height int64) (types.Validator, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QueryValidator(clientCtx util.ClientContext, cdc *codec.Codec, addr sdk.ValAddress, height int64) (types.Validator, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	res, _, err := cliCtx.QueryStore(types.KeyForValByAllVals(addr), types.StoreKey)
 	if err != nil {
 		return types.Validator{}, err
@@ -39,60 +38,42 @@ func (am AppModule) QueryValidator(cdc *codec.Codec, addr sdk.ValAddress, height
 	return types.MustUnmarshalValidator(cdc, res), nil
 }
 
-func (am AppModule) QueryValidators(cdc *codec.Codec, height int64) (types.Validators, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.AllValidatorsKey, types.StoreKey)
-	if err != nil {
-		return types.Validators{}, err
-	}
-	var validators types.Validators
-	for _, kv := range resKVs {
-		validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
-	}
-	return validators, nil
+func QueryValidators(clientCtx util.ClientContext, cdc *codec.Codec, height int64, params types.QueryValidatorsParams) (types.QueryValidatorsResponse, error) {
+	return queryValidators(clientCtx, cdc, types.QueryValidators, height, params)
 }
 
-func (am AppModule) QueryStakedValidators(cdc *codec.Codec, height int64) (types.Validators, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.StakedValidatorsKey, types.StoreKey)
-	if err != nil {
-		return types.Validators{}, err
-	}
-	var validators types.Validators
-	for _, kv := range resKVs {
-		validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
-	}
-	return validators, nil
+func QueryStakedValidators(clientCtx util.ClientContext, cdc *codec.Codec, height int64, params types.QueryValidatorsParams) (types.QueryValidatorsResponse, error) {
+	return queryValidators(clientCtx, cdc, types.QueryStakedValidators, height, params)
 }
 
-func (am AppModule) QueryUnstakedValidators(cdc *codec.Codec, height int64) (types.Validators, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.UnstakedValidatorsKey, types.StoreKey)
-	if err != nil {
-		return types.Validators{}, err
-	}
-	var validators types.Validators
-	for _, kv := range resKVs {
-		validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
-	}
-	return validators, nil
+func QueryUnstakedValidators(clientCtx util.ClientContext, cdc *codec.Codec, height int64, params types.QueryValidatorsParams) (types.QueryValidatorsResponse, error) {
+	return queryValidators(clientCtx, cdc, types.QueryUnstakedValidators, height, params)
 }
 
-func (am AppModule) QueryUnstakingValidators(cdc *codec.Codec, height int64) (types.Validators, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.UnstakingValidatorsKey, types.StoreKey)
+func QueryUnstakingValidators(clientCtx util.ClientContext, cdc *codec.Codec, height int64, params types.QueryValidatorsParams) (types.QueryValidatorsResponse, error) {
+	return queryValidators(clientCtx, cdc, types.QueryUnstakingValidators, height, params)
+}
+
+func queryValidators(clientCtx util.ClientContext, cdc *codec.Codec, queryRoute string, height int64, params types.QueryValidatorsParams) (types.QueryValidatorsResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return types.QueryValidatorsResponse{}, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, queryRoute)
+	res, _, err := cliCtx.QueryWithData(route, bz)
 	if err != nil {
-		return types.Validators{}, err
+		return types.QueryValidatorsResponse{}, err
 	}
-	var validators types.Validators
-	for _, kv := range resKVs {
-		validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
+	var validators types.QueryValidatorsResponse
+	if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+		return types.QueryValidatorsResponse{}, err
 	}
 	return validators, nil
 }
 
-func (am AppModule) QuerySigningInfo(cdc *codec.Codec, height int64, ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QuerySigningInfo(clientCtx util.ClientContext, cdc *codec.Codec, height int64, ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	key := types.GetValidatorSigningInfoKey(consAddr)
 	res, _, err := cliCtx.QueryStore(key, types.StoreKey)
 	if err != nil {
@@ -106,8 +87,42 @@ func (am AppModule) QuerySigningInfo(cdc *codec.Codec, height int64, ctx sdk.Con
 	return types.ValidatorSigningInfo{}, nil
 }
 
-func (am AppModule) QuerySupply(cdc *codec.Codec, height int64) (stakedCoins sdk.Int, unstakedCoins sdk.Int, err error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QueryValidatorUptime(clientCtx util.ClientContext, cdc *codec.Codec, consAddr sdk.ConsAddress, height int64) (types.ValidatorUptime, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(consAddr))
+	if err != nil {
+		return types.ValidatorUptime{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryValidatorUptime), bz)
+	if err != nil {
+		return types.ValidatorUptime{}, err
+	}
+	var uptime types.ValidatorUptime
+	if err := cdc.UnmarshalJSON(res, &uptime); err != nil {
+		return types.ValidatorUptime{}, err
+	}
+	return uptime, nil
+}
+
+func QueryUptimeTable(clientCtx util.ClientContext, cdc *codec.Codec, page, limit int, height int64) ([]types.ValidatorUptime, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQuerySigningInfosParams(page, limit))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryUptimeTable), bz)
+	if err != nil {
+		return nil, err
+	}
+	var table []types.ValidatorUptime
+	if err := cdc.UnmarshalJSON(res, &table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+func QuerySupply(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (stakedCoins sdk.Int, unstakedCoins sdk.Int, err error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	stakedPoolBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/stakedPool", types.StoreKey), nil)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
@@ -127,8 +142,8 @@ func (am AppModule) QuerySupply(cdc *codec.Codec, height int64) (stakedCoins sdk
 	return stakedPool.Tokens, unstakedPool.Tokens, nil
 }
 
-func (am AppModule) QueryDAO(cdc *codec.Codec, height int64) (daoCoins sdk.Int, err error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QueryDAO(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (daoCoins sdk.Int, err error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	daoPoolBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/dao", types.StoreKey), nil)
 	if err != nil {
 		return sdk.Int{}, err
@@ -140,8 +155,67 @@ func (am AppModule) QueryDAO(cdc *codec.Codec, height int64) (daoCoins sdk.Int,
 	return daoPool.Tokens, err
 }
 
-func (am AppModule) QueryPOSParams(cdc *codec.Codec, height int64) (types.Params, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), nil, "").WithCodec(cdc).WithHeight(height)
+func QuerySlashTotals(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (types.SlashDistribution, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QuerySlashTotals)
+	bz, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return types.SlashDistribution{}, err
+	}
+	var totals types.SlashDistribution
+	if err := cdc.UnmarshalJSON(bz, &totals); err != nil {
+		return types.SlashDistribution{}, err
+	}
+	return totals, nil
+}
+
+func QuerySlashes(clientCtx util.ClientContext, cdc *codec.Codec, addr sdk.ValAddress, startSequence uint64, page, limit int, height int64) ([]types.ValidatorHistoryRecord, error) {
+	return queryValidatorHistory(clientCtx, cdc, types.QuerySlashes, addr, startSequence, page, limit, height)
+}
+
+func QueryJailHistory(clientCtx util.ClientContext, cdc *codec.Codec, addr sdk.ValAddress, startSequence uint64, page, limit int, height int64) ([]types.ValidatorHistoryRecord, error) {
+	return queryValidatorHistory(clientCtx, cdc, types.QueryJailHistory, addr, startSequence, page, limit, height)
+}
+
+func queryValidatorHistory(clientCtx util.ClientContext, cdc *codec.Codec, queryRoute string, addr sdk.ValAddress, startSequence uint64, page, limit int, height int64) ([]types.ValidatorHistoryRecord, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorHistoryParams(addr, startSequence, page, limit))
+	if err != nil {
+		return nil, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, queryRoute)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+	var records []types.ValidatorHistoryRecord
+	if err := cdc.UnmarshalJSON(res, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// QueryHistoricalInfo returns the header and validator set snapshot of the block at infoHeight
+func QueryHistoricalInfo(clientCtx util.ClientContext, cdc *codec.Codec, infoHeight int64, height int64) (types.HistoricalInfo, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryHistoricalInfoParams(infoHeight))
+	if err != nil {
+		return types.HistoricalInfo{}, err
+	}
+	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryHistoricalInfo)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.HistoricalInfo{}, err
+	}
+	var hi types.HistoricalInfo
+	if err := cdc.UnmarshalJSON(res, &hi); err != nil {
+		return types.HistoricalInfo{}, err
+	}
+	return hi, nil
+}
+
+func QueryPOSParams(clientCtx util.ClientContext, cdc *codec.Codec, height int64) (types.Params, error) {
+	cliCtx := util.NewCLIContext(clientCtx, nil, "").WithCodec(cdc).WithHeight(height)
 	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryParameters)
 	bz, _, err := cliCtx.QueryWithData(route, nil)
 	if err != nil {
@@ -152,8 +226,8 @@ func (am AppModule) QueryPOSParams(cdc *codec.Codec, height int64) (types.Params
 	return params, nil
 }
 
-func (am AppModule) QueryBlock(height *int64) ([]byte, error) {
-	res, err := rpcclient.NewLocal(am.node).Block(height)
+func QueryBlock(clientCtx util.ClientContext, height *int64) ([]byte, error) {
+	res, err := clientCtx.Client().Block(height)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +236,8 @@ func (am AppModule) QueryBlock(height *int64) ([]byte, error) {
 }
 
 // get the current blockchain height
-func (am AppModule) QueryChainHeight() (int64, error) {
-	client := rpcclient.NewLocal(am.node)
+func QueryChainHeight(clientCtx util.ClientContext) (int64, error) {
+	client := clientCtx.Client()
 	status, err := client.Status()
 	if err != nil {
 		return -1, err
@@ -173,8 +247,8 @@ func (am AppModule) QueryChainHeight() (int64, error) {
 	return height, nil
 }
 
-func (am AppModule) QueryNodeStatus() (*ctypes.ResultStatus, error) {
-	res, err := rpcclient.NewLocal(am.GetTendermintNode()).Status()
+func QueryNodeStatus(clientCtx util.ClientContext) (*ctypes.ResultStatus, error) {
+	res, err := clientCtx.Client().Status()
 	if err != nil {
 		return nil, nil
 	}
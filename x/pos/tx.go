@@ -6,32 +6,36 @@ import (
 	"github.com/pokt-network/posmint/x/auth"
 	"github.com/pokt-network/posmint/x/auth/util"
 	"github.com/pokt-network/posmint/x/pos/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-func (am AppModule) StakeTx(cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), sdk.AccAddress(address), passphrase).WithCodec(cdc)
+// StakeTx stakes the validator with its consensus public key, which is the key of the validator's own node and not
+// necessarily the key of the node behind the client context
+func StakeTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, pubKey crypto.PubKey, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(address), passphrase).WithCodec(cdc)
 	msg := types.MsgStake{
 		Address: address,
-		PubKey:  am.node.PrivValidator().GetPubKey(),
+		PubKey:  pubKey,
 		Value:   amount,
 	}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
-func (am AppModule) UnstakeTx(cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), sdk.AccAddress(address), passphrase).WithCodec(cdc)
+func UnstakeTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(address), passphrase).WithCodec(cdc)
 	msg := types.MsgBeginUnstake{Address: address}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
-func (am AppModule) UnjailTx(cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), sdk.AccAddress(address), passphrase).WithCodec(cdc)
+func UnjailTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, address sdk.ValAddress, passphrase string) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(address), passphrase).WithCodec(cdc)
 	msg := types.MsgUnjail{ValidatorAddr: address}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
-func (am AppModule) Send(cdc *codec.Codec, fromAddr, toAddr sdk.ValAddress, txBuilder auth.TxBuilder, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
-	cliCtx := util.NewCLIContext(am.GetTendermintNode(), sdk.AccAddress(fromAddr), passphrase).WithCodec(cdc)
+func Send(clientCtx util.ClientContext, cdc *codec.Codec, fromAddr, toAddr sdk.ValAddress, txBuilder auth.TxBuilder, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(fromAddr), passphrase).WithCodec(cdc)
 	msg := types.MsgSend{
 		FromAddress: fromAddr,
 		ToAddress:   toAddr,
@@ -39,3 +43,12 @@ func (am AppModule) Send(cdc *codec.Codec, fromAddr, toAddr sdk.ValAddress, txBu
 	}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
+
+func SubmitEvidenceTx(clientCtx util.ClientContext, cdc *codec.Codec, txBuilder auth.TxBuilder, reporter sdk.ValAddress, passphrase string, evidence *tmtypes.DuplicateVoteEvidence) (*sdk.TxResponse, error) {
+	cliCtx := util.NewCLIContext(clientCtx, sdk.AccAddress(reporter), passphrase).WithCodec(cdc)
+	msg := types.MsgSubmitEvidence{
+		Reporter: reporter,
+		Evidence: evidence,
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
@@ -25,6 +25,8 @@ const (
 	CodeNotEnoughCoins        CodeType          = 112
 	CodeValidatorTombstoned   CodeType          = 113
 	CodeCantHandleEvidence    CodeType          = 114
+	CodeInvalidEvidence       CodeType          = 115
+	CodeNoHistoricalInfo      CodeType          = 116
 )
 
 func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -101,3 +103,11 @@ func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
 func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSigningInfo, fmt.Sprintf("no signing info found for address: %s", consAddr))
 }
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", reason))
+}
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoHistoricalInfo, fmt.Sprintf("no historical info found for height: %d", height))
+}
@@ -11,14 +11,23 @@ const (
 	EventTypeDAOAllocation         = "dao_allocation"
 	EventTypeSlash                 = "slash"
 	EventTypeLiveness              = "liveness"
+	EventTypeAutoUnjail            = "auto_unjail"
 	AttributeKeyAddress            = "address"
 	AttributeKeyHeight             = "height"
 	AttributeKeyPower              = "power"
 	AttributeKeyReason             = "reason"
 	AttributeKeyJailed             = "jailed"
 	AttributeKeyMissedBlocks       = "missed_blocks"
+	AttributeKeyOffenceCount       = "offence_count"
+	AttributeKeyJailedUntil        = "jailed_until"
+	AttributeKeyBurned             = "burned"
+	AttributeKeyDAOAllocation      = "dao_allocation"
+	AttributeKeyReporterBounty     = "reporter_bounty"
+	AttributeKeyReporter           = "reporter"
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueReportedEvidence = "reported_evidence"
+	AttributeValueBurn             = "burn"
 	AttributeKeyValidator          = "validator"
 	AttributeValueCategory         = ModuleName
 )
@@ -15,6 +15,7 @@ type GenesisState struct {
 	SigningInfos             map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks             map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
 	PreviousProposer         sdk.ConsAddress                 `json:"previous_proposer" yaml:"previous_proposer"`
+	SlashTotals              SlashDistribution               `json:"slash_totals" yaml:"slash_totals"`
 }
 
 // PrevState validator power, needed for validator set update logic
@@ -48,5 +49,6 @@ func DefaultGenesisState() GenesisState {
 		SigningInfos: make(map[string]ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
 		DAO:          DAOPool(NewPool(sdk.ZeroInt())),
+		SlashTotals:  NewSlashDistribution(),
 	}
 }
@@ -5,23 +5,23 @@ import (
 	"testing"
 
 	sdk "github.com/pokt-network/posmint/types"
-	"github.com/pokt-network/posmint/x/auth/types"
 )
 
 func TestDefaultGenesisState(t *testing.T) {
 	tests := []struct {
 		name string
-		want types.GenesisState
-	}{{"defaultState", types.GenesisState{
+		want GenesisState
+	}{{"defaultState", GenesisState{
 		Params:       DefaultParams(),
 		SigningInfos: make(map[string]ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
 		DAO:          DAOPool(NewPool(sdk.ZeroInt())),
+		SlashTotals:  NewSlashDistribution(),
 	}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := types.DefaultGenesisState(); !reflect.DeepEqual(got, tt.want) {
+			if got := DefaultGenesisState(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("DefaultGenesisState() = %v, want %v", got, tt.want)
 			}
 		})
@@ -30,12 +30,12 @@ func TestDefaultGenesisState(t *testing.T) {
 
 func TestNewGenesisState(t *testing.T) {
 	type args struct {
-		params           types.Params
-		validators       []types.Validator
-		dao              types.DAOPool
+		params           Params
+		validators       []Validator
+		dao              DAOPool
 		previousProposer sdk.ConsAddress
-		signingInfos     map[string]types.ValidatorSigningInfo
-		missedBlocks     map[string][]types.MissedBlock
+		signingInfos     map[string]ValidatorSigningInfo
+		missedBlocks     map[string][]MissedBlock
 	}
 	tests := []struct {
 		name string
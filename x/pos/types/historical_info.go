@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/posmint/codec"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// HistoricalInfo - the header of a block along with the validator set of the state it was begun in
+type HistoricalInfo struct {
+	Header abci.Header `json:"header" yaml:"header"`
+	ValSet []Validator `json:"valset" yaml:"valset"`
+}
+
+// NewHistoricalInfo returns the historical info of the block with header
+func NewHistoricalInfo(header abci.Header, valSet []Validator) HistoricalInfo {
+	return HistoricalInfo{Header: header, ValSet: valSet}
+}
+
+// String returns a human readable string representation of the historical info.
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:     %d
+  Time:       %v
+  App Hash:   %X
+  Validators: %d`, hi.Header.Height, hi.Header.Time, hi.Header.AppHash, len(hi.ValSet))
+}
+
+// marshal the historical info
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// unmarshal the historical info from store value or panic
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	var hi HistoricalInfo
+	cdc.MustUnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// validator history actions
+const (
+	HistoryActionSlash     = "slash"
+	HistoryActionJail      = "jail"
+	HistoryActionUnjail    = "unjail"
+	HistoryActionTombstone = "tombstone"
+)
+
+// ValidatorHistoryRecord - a single slash, jail, unjail or tombstone event of a validator
+type ValidatorHistoryRecord struct {
+	Sequence         uint64         `json:"sequence" yaml:"sequence"`                   // global sequence of the record
+	Address          sdk.ValAddress `json:"address" yaml:"address"`                     // address of the validator
+	Action           string         `json:"action" yaml:"action"`                       // slash, jail, unjail or tombstone
+	Height           int64          `json:"height" yaml:"height"`                       // block height the record was written at
+	Time             time.Time      `json:"time" yaml:"time"`                           // block time the record was written at
+	InfractionHeight int64          `json:"infraction_height" yaml:"infraction_height"` // height of the infraction (if any)
+	EvidenceType     string         `json:"evidence_type" yaml:"evidence_type"`         // reason for the action (double_sign, missing_signature...)
+	Fraction         sdk.Dec        `json:"fraction" yaml:"fraction"`                   // slash fraction applied
+	TokensSlashed    sdk.Int        `json:"tokens_slashed" yaml:"tokens_slashed"`       // tokens removed from the validator's stake
+	Status           sdk.BondStatus `json:"status" yaml:"status"`                       // resulting status of the validator
+	Jailed           bool           `json:"jailed" yaml:"jailed"`                       // resulting jailed flag of the validator
+}
+
+// returns true if the record belongs in the jail history (jail, unjail or tombstone)
+func (r ValidatorHistoryRecord) IsJailRecord() bool {
+	return r.Action == HistoryActionJail || r.Action == HistoryActionUnjail || r.Action == HistoryActionTombstone
+}
+
+// String returns a human readable string representation of a history record.
+func (r ValidatorHistoryRecord) String() string {
+	return fmt.Sprintf(`Validator History Record:
+  Sequence:          %d
+  Address:           %s
+  Action:            %s
+  Height:            %d
+  Time:              %v
+  Infraction Height: %d
+  Evidence Type:     %s
+  Fraction:          %s
+  Tokens Slashed:    %s
+  Status:            %s
+  Jailed:            %t`,
+		r.Sequence, r.Address, r.Action, r.Height, r.Time, r.InfractionHeight,
+		r.EvidenceType, r.Fraction, r.TokensSlashed, r.Status, r.Jailed)
+}
@@ -19,15 +19,20 @@ var ( // Keys for store prefixes
 	ValidatorSigningInfoKey         = []byte{0x11} // Prefix for signing info used in slashing
 	ValidatorMissedBlockBitArrayKey = []byte{0x12} // Prefix for missed block bit array used in slashing
 	AddrPubkeyRelationKey           = []byte{0x13} // Prefix for address-pubkey relation used in slashing
+	JailedValidatorsQueueKey        = []byte{0x14} // prefix for the queue of jailed validators by release time
+	ValidatorHistoryKey             = []byte{0x15} // prefix for the slash and jail history of each validator
+	ValidatorHistoryByHeightKey     = []byte{0x16} // prefix for the history index by height used in pruning
+	ValidatorHistorySequenceKey     = []byte{0x17} // key for the last history record sequence
 	AllValidatorsKey                = []byte{0x21} // prefix for each key to a validator
 	AllValidatorsByConsensusAddrKey = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	StakedValidatorsKey             = []byte{0x23} // prefix for each key to a staked validator index, sorted by power
 	PrevStateValidatorsPowerKey     = []byte{0x31} // prefix for the key to the validators of the prevState state
 	PrevStateTotalPowerKey          = []byte{0x32} // prefix for the total power of the prevState state
+	HistoricalInfoKey               = []byte{0x33} // prefix for the header and validator set snapshots by height
 	UnstakingValidatorsKey          = []byte{0x41} // prefix for unstaking validator
-	UnstakedValidatorsKey           = []byte{0x42} // prefix for unstaked validators // todo remove
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
+	SlashTotalsKey                  = []byte{0x53} // key for the running totals of slashed token distribution
 )
 
 // generates the key for the validator with address
@@ -46,6 +51,38 @@ func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	return append(UnstakingValidatorsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for jailed validators by their release time
+func KeyForJailedValidatorsQueue(releaseTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(releaseTime)
+	return append(JailedValidatorsQueueKey, bz...)
+}
+
+// generates the prefix key for the history records of a validator
+func KeyForValidatorHistoryPrefix(addr sdk.ValAddress) []byte {
+	return append(ValidatorHistoryKey, addr.Bytes()...)
+}
+
+// generates the key for a validator history record
+func KeyForValidatorHistory(addr sdk.ValAddress, sequence uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, sequence)
+	return append(KeyForValidatorHistoryPrefix(addr), b...)
+}
+
+// generates the key for the history height index
+// NOTE the key is of format prefix || height || sequence and maps to the validator address
+func KeyForValidatorHistoryByHeight(height int64, sequence uint64) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], uint64(height))
+	binary.BigEndian.PutUint64(b[8:], sequence)
+	return append(ValidatorHistoryByHeightKey, b...)
+}
+
+// extract the sequence from a history height index key
+func SequenceFromValidatorHistoryByHeightKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[9:])
+}
+
 // generates the key for a validator in the staking set
 func KeyForValidatorInStakingSet(validator Validator) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
@@ -57,6 +94,13 @@ func KeyForValidatorPrevStateStateByPower(address sdk.ValAddress) []byte {
 	return append(PrevStateValidatorsPowerKey, address...)
 }
 
+// generates the key for the historical info at height
+func KeyForHistoricalInfo(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(HistoricalInfoKey, b...)
+}
+
 // generates the award key for a validator in the current state
 func KeyForValidatorAward(address sdk.ValAddress) []byte {
 	return append(AwardValidatorKey, address...)
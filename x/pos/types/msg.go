@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -12,6 +13,7 @@ var (
 	_ sdk.Msg = &MsgBeginUnstake{}
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgSubmitEvidence{}
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -132,3 +134,35 @@ func (msg MsgSend) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgSubmitEvidence - struct for submitting application level double sign evidence
+type MsgSubmitEvidence struct {
+	Reporter sdk.ValAddress                 `json:"reporter" yaml:"reporter"` // address that receives the reporter bounty
+	Evidence *tmtypes.DuplicateVoteEvidence `json:"evidence" yaml:"evidence"`
+}
+
+//nolint
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+func (msg MsgSubmitEvidence) Type() string  { return "submit_evidence" }
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Reporter)}
+}
+
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Reporter.Empty() {
+		return ErrBadValidatorAddr(DefaultCodespace)
+	}
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "evidence is nil")
+	}
+	if err := msg.Evidence.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/pokt-network/posmint/codec"
@@ -19,6 +20,11 @@ const (
 	DefaultMaxEvidenceAge                     = 60 * 2 * time.Second
 	DefaultSignedBlocksWindow                 = int64(100)
 	DefaultDowntimeJailDuration               = 60 * 10 * time.Second
+	DefaultOffenceDecayPeriod                 = time.Hour * 24
+	DefaultAutoUnjail                         = false
+	DefaultHistoryRetentionBlocks             = int64(0)
+	DefaultHistoricalEntries           uint64 = 0
+	MaxHistoricalEntries               uint64 = 100000
 )
 
 // nolint - Keys for parameter access
@@ -34,10 +40,19 @@ var (
 	KeyDowntimeJailDuration        = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign     = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime       = []byte("SlashFractionDowntime")
+	KeyDowntimeEscalation          = []byte("DowntimeEscalation")
+	KeyOffenceDecayPeriod          = []byte("OffenceDecayPeriod")
+	KeyAutoUnjail                  = []byte("AutoUnjail")
+	KeySlashDAOShare               = []byte("SlashDAOShare")
+	KeySlashReporterShare          = []byte("SlashReporterShare")
+	KeyHistoryRetentionBlocks      = []byte("HistoryRetentionBlocks")
+	KeyHistoricalEntries           = []byte("HistoricalEntries")
 	DoubleSignJailEndTime          = time.Unix(253402300799, 0) // forever
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultSlashDAOShare           = sdk.ZeroDec()
+	DefaultSlashReporterShare      = sdk.NewDec(1).Quo(sdk.NewDec(10))
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -56,22 +71,72 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// escalation params
+	DowntimeEscalation []DowntimePenalty `json:"downtime_escalation" yaml:"downtime_escalation"`   // penalties applied per repeat offence
+	OffenceDecayPeriod time.Duration     `json:"offence_decay_period" yaml:"offence_decay_period"` // clean period after which one offence is forgiven
+	AutoUnjail         bool              `json:"auto_unjail" yaml:"auto_unjail"`                   // unjail validators automatically once their term expires
+	// slash distribution params (the remainder is burned)
+	SlashDAOShare      sdk.Dec `json:"slash_dao_share" yaml:"slash_dao_share"`           // share of slashed tokens sent to the dao
+	SlashReporterShare sdk.Dec `json:"slash_reporter_share" yaml:"slash_reporter_share"` // share of slashed tokens paid to the evidence reporter
+	// number of blocks slash and jail history is kept for (0 keeps it forever)
+	HistoryRetentionBlocks int64 `json:"history_retention_blocks" yaml:"history_retention_blocks"`
+	// number of blocks a header and validator set snapshot is kept for (0 keeps none)
+	HistoricalEntries uint64 `json:"historical_entries" yaml:"historical_entries"`
+}
+
+// DowntimePenalty is a single step of the downtime escalation table
+type DowntimePenalty struct {
+	JailDuration  time.Duration `json:"jail_duration" yaml:"jail_duration"`
+	SlashFraction sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`
+}
+
+// SuggestedDowntimeEscalation returns an escalation table a chain can opt into, starting from the default flat
+// penalty. It isn't enabled by default, so that DowntimeJailDuration and SlashFractionDowntime stay authoritative
+func SuggestedDowntimeEscalation() []DowntimePenalty {
+	return []DowntimePenalty{
+		{JailDuration: DefaultDowntimeJailDuration, SlashFraction: DefaultSlashFractionDowntime},
+		{JailDuration: DefaultDowntimeJailDuration * 6, SlashFraction: DefaultSlashFractionDowntime.MulInt64(2)},
+		{JailDuration: DefaultDowntimeJailDuration * 6 * 24, SlashFraction: DefaultSlashFractionDowntime.MulInt64(5)},
+	}
+}
+
+// returns the downtime penalty for the offence count (1 = first offence)
+// NOTE: an empty table falls back to the flat DowntimeJailDuration and SlashFractionDowntime
+func (p Params) DowntimePenaltyFor(offenceCount int64) DowntimePenalty {
+	if len(p.DowntimeEscalation) == 0 {
+		return DowntimePenalty{JailDuration: p.DowntimeJailDuration, SlashFraction: p.SlashFractionDowntime}
+	}
+	index := offenceCount - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= int64(len(p.DowntimeEscalation)) {
+		index = int64(len(p.DowntimeEscalation)) - 1
+	}
+	return p.DowntimeEscalation[index]
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{Key: KeyUnstakingTime, Value: &p.UnstakingTime},
-		{Key: KeyMaxValidators, Value: &p.MaxValidators},
-		{Key: KeyStakeDenom, Value: &p.StakeDenom},
-		{Key: KeyStakeMinimum, Value: &p.StakeMinimum},
-		{Key: KeyMaxEvidenceAge, Value: &p.MaxEvidenceAge},
-		{Key: KeySignedBlocksWindow, Value: &p.SignedBlocksWindow},
-		{Key: KeyMinSignedPerWindow, Value: &p.MinSignedPerWindow},
-		{Key: KeyDowntimeJailDuration, Value: &p.DowntimeJailDuration},
-		{Key: KeySlashFractionDoubleSign, Value: &p.SlashFractionDoubleSign},
-		{Key: KeySlashFractionDowntime, Value: &p.SlashFractionDowntime},
-		{Key: KeyProposerRewardPercentage, Value: &p.ProposerRewardPercentage},
+		params.NewParamSetPair(KeyUnstakingTime, &p.UnstakingTime, validateNonNegativeDuration),
+		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair(KeyStakeDenom, &p.StakeDenom, validateStakeDenom),
+		params.NewParamSetPair(KeyStakeMinimum, &p.StakeMinimum, validateStakeMinimum),
+		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateNonNegativeDuration),
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateFraction),
+		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateNonNegativeDuration),
+		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateFraction),
+		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateFraction),
+		params.NewParamSetPair(KeyProposerRewardPercentage, &p.ProposerRewardPercentage, validateProposerRewardPercentage),
+		params.NewParamSetPair(KeyDowntimeEscalation, &p.DowntimeEscalation, validateDowntimeEscalation),
+		params.NewParamSetPair(KeyOffenceDecayPeriod, &p.OffenceDecayPeriod, validateNonNegativeDuration),
+		params.NewParamSetPair(KeyAutoUnjail, &p.AutoUnjail, nil),
+		params.NewParamSetPair(KeySlashDAOShare, &p.SlashDAOShare, validateFraction),
+		params.NewParamSetPair(KeySlashReporterShare, &p.SlashReporterShare, validateFraction),
+		params.NewParamSetPair(KeyHistoryRetentionBlocks, &p.HistoryRetentionBlocks, validateHistoryRetentionBlocks),
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 	}
 }
 
@@ -89,22 +154,151 @@ func DefaultParams() Params {
 		DowntimeJailDuration:     DefaultDowntimeJailDuration,
 		SlashFractionDoubleSign:  DefaultSlashFractionDoubleSign,
 		SlashFractionDowntime:    DefaultSlashFractionDowntime,
+		DowntimeEscalation:       []DowntimePenalty{},
+		OffenceDecayPeriod:       DefaultOffenceDecayPeriod,
+		AutoUnjail:               DefaultAutoUnjail,
+		SlashDAOShare:            DefaultSlashDAOShare,
+		SlashReporterShare:       DefaultSlashReporterShare,
+		HistoryRetentionBlocks:   DefaultHistoryRetentionBlocks,
+		HistoricalEntries:        DefaultHistoricalEntries,
 	}
 }
 
 // validate a set of params
 func (p Params) Validate() error {
-	if p.StakeDenom == "" {
-		return fmt.Errorf("staking parameter StakeDenom can't be an empty string")
+	for _, pair := range p.ParamSetPairs() {
+		if pair.ValidatorFn == nil {
+			continue
+		}
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return fmt.Errorf("staking parameter %s is invalid: %s", pair.Key, err)
+		}
+	}
+	if p.SlashDAOShare.Add(p.SlashReporterShare).GT(sdk.OneDec()) {
+		return fmt.Errorf("slash dao share and reporter share must not sum to more than one, is %s", p.SlashDAOShare.Add(p.SlashReporterShare))
+	}
+	return nil
+}
+
+func validateNonNegativeDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("must not be negative, is %s", v)
+	}
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func validateStakeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return fmt.Errorf("can't be an empty string")
+	}
+	return nil
+}
+
+func validateStakeMinimum(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < DefaultMinStake {
+		return fmt.Errorf("must be at least %d, is %d", DefaultMinStake, v)
+	}
+	return nil
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.MaxValidators == 0 {
-		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
+	if v <= 0 {
+		return fmt.Errorf("must be positive, is %d", v)
+	}
+	return nil
+}
+
+// fractions and shares are between zero and one
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be between zero and one, is %s", v)
+	}
+	return nil
+}
+
+func validateProposerRewardPercentage(i interface{}) error {
+	v, ok := i.(int8)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.StakeMinimum < DefaultMinStake {
-		return fmt.Errorf("staking parameter StakeMimimum must be a positive integer")
+	if v < 0 || v > 100 {
+		return fmt.Errorf("is a percentage and must be between 0 and 100, is %d", v)
+	}
+	return nil
+}
+
+func validateDowntimeEscalation(i interface{}) error {
+	v, ok := i.([]DowntimePenalty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the penalties escalate: no step is lighter than the previous one
+	for n, step := range v {
+		if step.JailDuration < 0 {
+			return fmt.Errorf("step %d jail duration must not be negative, is %s", n, step.JailDuration)
+		}
+		if step.SlashFraction.IsNil() || step.SlashFraction.IsNegative() || step.SlashFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("step %d slash fraction must be between zero and one, is %s", n, step.SlashFraction)
+		}
+		if n == 0 {
+			continue
+		}
+		if previous := v[n-1]; step.JailDuration < previous.JailDuration || step.SlashFraction.LT(previous.SlashFraction) {
+			return fmt.Errorf("step %d must not be lighter than step %d", n, n-1)
+		}
+	}
+	return nil
+}
+
+func validateHistoryRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("must not be negative, is %d", v)
+	}
+	return nil
+}
+
+func validateHistoricalEntries(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.ProposerRewardPercentage < 0 || p.ProposerRewardPercentage > 100 {
-		return fmt.Errorf("base proposer award is a percentage and must be between 0 and 100")
+	if v > MaxHistoricalEntries {
+		return fmt.Errorf("must be at most %d, is %d", MaxHistoricalEntries, v)
 	}
 	return nil
 }
@@ -129,7 +323,14 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SlashFractionDowntime:   %s
+  DowntimeEscalation:      %v
+  OffenceDecayPeriod:      %s
+  AutoUnjail:              %t
+  SlashDAOShare:           %s
+  SlashReporterShare:      %s
+  HistoryRetentionBlocks:  %d
+  HistoricalEntries:       %d`,
 		p.UnstakingTime,
 		p.MaxValidators,
 		p.StakeDenom,
@@ -140,7 +341,14 @@ func (p Params) String() string {
 		p.MinSignedPerWindow,
 		p.DowntimeJailDuration,
 		p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime,
+		p.DowntimeEscalation,
+		p.OffenceDecayPeriod,
+		p.AutoUnjail,
+		p.SlashDAOShare,
+		p.SlashReporterShare,
+		p.HistoryRetentionBlocks,
+		p.HistoricalEntries)
 }
 
 // unmarshal the current pos params value from store key or panic
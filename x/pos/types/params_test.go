@@ -248,3 +248,47 @@ func TestUnmarshalParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_DowntimePenaltyFor(t *testing.T) {
+	escalation := SuggestedDowntimeEscalation()
+	flat := DowntimePenalty{JailDuration: DefaultDowntimeJailDuration, SlashFraction: DefaultSlashFractionDowntime}
+	tests := []struct {
+		name         string
+		escalation   []DowntimePenalty
+		offenceCount int64
+		want         DowntimePenalty
+	}{
+		{"empty table falls back to flat params", nil, 3, flat},
+		{"zero offences uses the first step", escalation, 0, escalation[0]},
+		{"first offence uses the first step", escalation, 1, escalation[0]},
+		{"second offence uses the second step", escalation, 2, escalation[1]},
+		{"offences past the table use the last step", escalation, 10, escalation[len(escalation)-1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := DefaultParams()
+			p.DowntimeEscalation = tt.escalation
+			if got := p.DowntimePenaltyFor(tt.offenceCount); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DowntimePenaltyFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDowntimeEscalation(t *testing.T) {
+	escalation := SuggestedDowntimeEscalation()
+	if err := validateDowntimeEscalation(escalation); err != nil {
+		t.Errorf("validateDowntimeEscalation() error = %v", err)
+	}
+	if err := validateDowntimeEscalation([]DowntimePenalty{}); err != nil {
+		t.Errorf("validateDowntimeEscalation() error = %v for an empty table", err)
+	}
+	lighterJail := []DowntimePenalty{escalation[1], escalation[0]}
+	if err := validateDowntimeEscalation(lighterJail); err == nil {
+		t.Errorf("validateDowntimeEscalation() accepted a decreasing jail duration")
+	}
+	lighterSlash := []DowntimePenalty{escalation[0], {JailDuration: escalation[1].JailDuration, SlashFraction: types.ZeroDec()}}
+	if err := validateDowntimeEscalation(lighterSlash); err == nil {
+		t.Errorf("validateDowntimeEscalation() accepted a decreasing slash fraction")
+	}
+}
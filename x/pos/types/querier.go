@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -18,6 +20,12 @@ const (
 	QuerySigningInfo         = "signingInfo"
 	QuerySigningInfos        = "signingInfos"
 	QueryAccountBalance      = "account_balance"
+	QuerySlashTotals         = "slash_totals"
+	QuerySlashes             = "slashes"
+	QueryJailHistory         = "jail_history"
+	QueryValidatorUptime     = "validator_uptime"
+	QueryUptimeTable         = "uptime_table"
+	QueryHistoricalInfo      = "historical_info"
 )
 
 type QueryValidatorParams struct {
@@ -30,40 +38,116 @@ func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams
 	}
 }
 
+// validator status filters
+const (
+	StatusFilterStaked    = "staked"
+	StatusFilterUnstaking = "unstaking"
+	StatusFilterUnstaked  = "unstaked"
+)
+
+// validator sort orders
+const (
+	SortByStake   = "stake"   // descending by staked tokens
+	SortByAddress = "address" // ascending by address
+)
+
+// page sizes of the validator list queries
+const (
+	DefaultValidatorsQueryLimit = 100  // page size of a query without a limit
+	MaxValidatorsQueryLimit     = 1000 // larger limits are capped to it
+)
+
+// QueryValidatorsParams defines the params for all validator list queries
+// NOTE: empty filters match every validator and an empty SortBy keeps the natural order of the route
 type QueryValidatorsParams struct {
 	Page, Limit int
+	Status      string  // one of staked, unstaking, unstaked
+	Jailed      *bool   // nil matches jailed and unjailed validators
+	MinStake    sdk.Int // minimum staked tokens (nil for no minimum)
+	SortBy      string  // one of stake, address
+	SkipTotal   bool    // don't count the matches after the page, so the iteration can stop at its end (Total is -1)
 }
 
 func NewQueryValidatorsParams(page, limit int) QueryValidatorsParams {
-	return QueryValidatorsParams{page, limit}
+	return QueryValidatorsParams{Page: page, Limit: limit}
+}
+
+// validate the filters and sort order
+func (p QueryValidatorsParams) Validate() error {
+	switch p.Status {
+	case "", StatusFilterStaked, StatusFilterUnstaking, StatusFilterUnstaked:
+	default:
+		return fmt.Errorf("invalid status filter: %s", p.Status)
+	}
+	switch p.SortBy {
+	case "", SortByStake, SortByAddress:
+	default:
+		return fmt.Errorf("invalid sort order: %s", p.SortBy)
+	}
+	if p.HasMinStake() && p.MinStake.IsNegative() {
+		return fmt.Errorf("minimum stake must not be negative: %s", p.MinStake)
+	}
+	return nil
+}
+
+// returns true if a minimum stake filter was provided
+func (p QueryValidatorsParams) HasMinStake() bool {
+	return p.MinStake != (sdk.Int{})
+}
+
+// returns true if the validator passes all of the filters
+func (p QueryValidatorsParams) Matches(validator Validator) bool {
+	switch p.Status {
+	case StatusFilterStaked:
+		if !validator.IsStaked() {
+			return false
+		}
+	case StatusFilterUnstaking:
+		if !validator.IsUnstaking() {
+			return false
+		}
+	case StatusFilterUnstaked:
+		if !validator.IsUnstaked() {
+			return false
+		}
+	}
+	if p.Jailed != nil && validator.Jailed != *p.Jailed {
+		return false
+	}
+	if p.HasMinStake() && validator.StakedTokens.LT(p.MinStake) {
+		return false
+	}
+	return true
+}
+
+// QueryValidatorsResponse is a page of validators along with the total number of matches
+type QueryValidatorsResponse struct {
+	Validators Validators `json:"validators" yaml:"validators"`
+	Total      int        `json:"total" yaml:"total"` // total number of validators matching the filters, -1 if skipped
+	Page       int        `json:"page" yaml:"page"`
+	Limit      int        `json:"limit" yaml:"limit"`
 }
 
 type QueryAccountBalanceParams struct {
 	sdk.ValAddress
 }
 
-type QueryUnstakingValidatorsParams struct {
-	Page, Limit int
-}
+type QueryUnstakingValidatorsParams = QueryValidatorsParams
 
 func NewQueryUnstakingValidatorsParams(page, limit int) QueryUnstakingValidatorsParams {
-	return QueryUnstakingValidatorsParams{page, limit}
+	return NewQueryValidatorsParams(page, limit)
 }
 
-type QueryStakedValidatorsParams struct {
-	Page, Limit int
-}
+type QueryStakedValidatorsParams = QueryValidatorsParams
 
 func NewQueryStakedValidatorsParams(page, limit int) QueryStakedValidatorsParams {
-	return QueryStakedValidatorsParams{page, limit}
+	return NewQueryValidatorsParams(page, limit)
 }
 
-type QueryUnstakedValidatorsParams struct {
-	Page, Limit int
-}
+type QueryUnstakedValidatorsParams = QueryValidatorsParams
 
 func NewQueryUnstakedValidatorsParams(page, limit int) QueryUnstakedValidatorsParams {
-	return QueryUnstakedValidatorsParams{page, limit}
+	return NewQueryValidatorsParams(page, limit)
 }
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -76,6 +160,10 @@ func NewQuerySigningInfoParams(consAddr sdk.ConsAddress) QuerySigningInfoParams
 	return QuerySigningInfoParams{consAddr}
 }
 
+// QueryValidatorUptimeParams defines the params for the following queries:
+// - 'custom/pos/validator_uptime'
+type QueryValidatorUptimeParams = QuerySigningInfoParams
+
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/slashing/signingInfos'
 type QuerySigningInfosParams struct {
@@ -85,3 +173,31 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// QueryUptimeTableParams defines the params for the following queries:
+// - 'custom/pos/uptime_table'
+type QueryUptimeTableParams = QuerySigningInfosParams
+
+// QueryValidatorHistoryParams defines the params for the following queries:
+// - 'custom/pos/slashes'
+// - 'custom/pos/jail_history'
+// the pages start below StartSequence, the sequence of the last record of a previous page (0 for the newest record)
+type QueryValidatorHistoryParams struct {
+	Address       sdk.ValAddress
+	StartSequence uint64
+	Page, Limit   int
+}
+
+func NewQueryValidatorHistoryParams(validatorAddr sdk.ValAddress, startSequence uint64, page, limit int) QueryValidatorHistoryParams {
+	return QueryValidatorHistoryParams{validatorAddr, startSequence, page, limit}
+}
+
+// QueryHistoricalInfoParams defines the params for the following queries:
+// - 'custom/pos/historical_info'
+type QueryHistoricalInfoParams struct {
+	Height int64
+}
+
+func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
+	return QueryHistoricalInfoParams{height}
+}
@@ -15,6 +15,20 @@ type ValidatorSigningInfo struct {
 	JailedUntil         time.Time       `json:"jailed_until" yaml:"jailed_until"`                   // timestamp validator cannot be unjailed until
 	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+	OffenceCount        int64           `json:"offence_count" yaml:"offence_count"`                 // rolling count of downtime offences
+	LastOffenceTime     time.Time       `json:"last_offence_time" yaml:"last_offence_time"`         // timestamp of the most recent downtime offence
+}
+
+// returns the offence count after forgiving one offence per clean decay period since the last offence
+func (i ValidatorSigningInfo) DecayedOffenceCount(now time.Time, decayPeriod time.Duration) int64 {
+	if i.OffenceCount <= 0 || decayPeriod <= 0 || !now.After(i.LastOffenceTime) {
+		return i.OffenceCount
+	}
+	forgiven := int64(now.Sub(i.LastOffenceTime) / decayPeriod)
+	if forgiven >= i.OffenceCount {
+		return 0
+	}
+	return i.OffenceCount - forgiven
 }
 
 // Return human readable signing info
@@ -25,7 +39,9 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Offence Count:         %d
+  Last Offence Time:     %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.OffenceCount, i.LastOffenceTime)
 }
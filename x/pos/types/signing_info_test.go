@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidatorSigningInfo_DecayedOffenceCount(t *testing.T) {
+	lastOffence := time.Unix(1000, 0)
+	tests := []struct {
+		name         string
+		offenceCount int64
+		now          time.Time
+		decayPeriod  time.Duration
+		want         int64
+	}{
+		{"no offences", 0, lastOffence.Add(time.Hour), time.Minute, 0},
+		{"decay disabled", 3, lastOffence.Add(time.Hour), 0, 3},
+		{"within the clean period", 3, lastOffence.Add(time.Minute), time.Hour, 3},
+		{"one clean period elapsed", 3, lastOffence.Add(time.Hour), time.Hour, 2},
+		{"all offences forgiven", 3, lastOffence.Add(10 * time.Hour), time.Hour, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := ValidatorSigningInfo{OffenceCount: tt.offenceCount, LastOffenceTime: lastOffence}
+			if got := i.DecayedOffenceCount(tt.now, tt.decayPeriod); got != tt.want {
+				t.Errorf("DecayedOffenceCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
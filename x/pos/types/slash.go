@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// SlashDistribution - how slashed tokens were split between burning, the dao and the evidence reporter
+type SlashDistribution struct {
+	Burned         sdk.Int `json:"burned" yaml:"burned"`
+	DAOAllocation  sdk.Int `json:"dao_allocation" yaml:"dao_allocation"`
+	ReporterBounty sdk.Int `json:"reporter_bounty" yaml:"reporter_bounty"`
+}
+
+// returns a zeroed slash distribution
+func NewSlashDistribution() SlashDistribution {
+	return SlashDistribution{
+		Burned:         sdk.ZeroInt(),
+		DAOAllocation:  sdk.ZeroInt(),
+		ReporterBounty: sdk.ZeroInt(),
+	}
+}
+
+// splits the slashed amount by the dao and reporter shares, the remainder is burned
+// NOTE: the reporter share is burned when there is no reporter (i.e. Tendermint evidence)
+// NOTE: the shares are updated one at a time, so they may sum to more than one: the reporter bounty is then capped
+// to what is left after the dao allocation
+func SplitSlashedTokens(amount sdk.Int, daoShare, reporterShare sdk.Dec, hasReporter bool) SlashDistribution {
+	d := NewSlashDistribution()
+	if !amount.IsPositive() {
+		return d
+	}
+	d.DAOAllocation = sdk.MinInt(amount.ToDec().Mul(daoShare).TruncateInt(), amount)
+	if hasReporter {
+		d.ReporterBounty = sdk.MinInt(amount.ToDec().Mul(reporterShare).TruncateInt(), amount.Sub(d.DAOAllocation))
+	}
+	d.Burned = amount.Sub(d.DAOAllocation).Sub(d.ReporterBounty)
+	return d
+}
+
+// returns true if the distribution was never initialized (e.g. absent from an older genesis file)
+func (d SlashDistribution) IsNil() bool {
+	return d.Burned == (sdk.Int{}) || d.DAOAllocation == (sdk.Int{}) || d.ReporterBounty == (sdk.Int{})
+}
+
+// returns the sum of two slash distributions
+func (d SlashDistribution) Add(d2 SlashDistribution) SlashDistribution {
+	return SlashDistribution{
+		Burned:         d.Burned.Add(d2.Burned),
+		DAOAllocation:  d.DAOAllocation.Add(d2.DAOAllocation),
+		ReporterBounty: d.ReporterBounty.Add(d2.ReporterBounty),
+	}
+}
+
+// returns the total amount slashed
+func (d SlashDistribution) Total() sdk.Int {
+	return d.Burned.Add(d.DAOAllocation).Add(d.ReporterBounty)
+}
+
+// String returns a human readable string representation of a slash distribution.
+func (d SlashDistribution) String() string {
+	return fmt.Sprintf(`Slash Distribution:
+  Burned:          %s
+  DAO Allocation:  %s
+  Reporter Bounty: %s`, d.Burned, d.DAOAllocation, d.ReporterBounty)
+}
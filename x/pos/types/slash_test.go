@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+func TestSplitSlashedTokens(t *testing.T) {
+	tenPercent := sdk.NewDecWithPrec(1, 1)
+	twentyPercent := sdk.NewDecWithPrec(2, 1)
+	tests := []struct {
+		name          string
+		amount        sdk.Int
+		daoShare      sdk.Dec
+		reporterShare sdk.Dec
+		hasReporter   bool
+		want          SlashDistribution
+	}{
+		{"nothing slashed", sdk.ZeroInt(), tenPercent, tenPercent, true, NewSlashDistribution()},
+		{"burn everything", sdk.NewInt(100), sdk.ZeroDec(), sdk.ZeroDec(), true,
+			SlashDistribution{Burned: sdk.NewInt(100), DAOAllocation: sdk.ZeroInt(), ReporterBounty: sdk.ZeroInt()}},
+		{"split with reporter", sdk.NewInt(100), twentyPercent, tenPercent, true,
+			SlashDistribution{Burned: sdk.NewInt(70), DAOAllocation: sdk.NewInt(20), ReporterBounty: sdk.NewInt(10)}},
+		{"reporter share burned without reporter", sdk.NewInt(100), twentyPercent, tenPercent, false,
+			SlashDistribution{Burned: sdk.NewInt(80), DAOAllocation: sdk.NewInt(20), ReporterBounty: sdk.ZeroInt()}},
+		{"reporter bounty capped when the shares sum to more than one", sdk.NewInt(100), sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(8, 1), true,
+			SlashDistribution{Burned: sdk.ZeroInt(), DAOAllocation: sdk.NewInt(80), ReporterBounty: sdk.NewInt(20)}},
+		{"remainder of truncation is burned", sdk.NewInt(9), tenPercent, tenPercent, true,
+			SlashDistribution{Burned: sdk.NewInt(9), DAOAllocation: sdk.ZeroInt(), ReporterBounty: sdk.ZeroInt()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitSlashedTokens(tt.amount, tt.daoShare, tt.reporterShare, tt.hasReporter)
+			if got.String() != tt.want.String() {
+				t.Errorf("SplitSlashedTokens() = %v, want %v", got, tt.want)
+			}
+			if !got.Total().Equal(tt.amount) {
+				t.Errorf("SplitSlashedTokens() total = %v, want %v", got.Total(), tt.amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// ValidatorUptime - liveness summary of a validator over the current signed blocks window
+type ValidatorUptime struct {
+	Address             sdk.ConsAddress `json:"address" yaml:"address"`                             // validator consensus address
+	SignedBlocksWindow  int64           `json:"signed_blocks_window" yaml:"signed_blocks_window"`   // size of the window
+	BlocksCounted       int64           `json:"blocks_counted" yaml:"blocks_counted"`               // blocks of the window the validator should have signed
+	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // blocks of the window the validator missed
+	MissedBlocks        []bool          `json:"missed_blocks,omitempty" yaml:"missed_blocks"`       // missed block bitmap of the window, oldest first
+	Uptime              sdk.Dec         `json:"uptime" yaml:"uptime"`                               // fraction of counted blocks signed
+	ProjectedJailHeight int64           `json:"projected_jail_height" yaml:"projected_jail_height"` // height of the projected downtime jail (0 if none)
+}
+
+// computes the uptime summary of a validator from its signing info
+// maxMissed is the number of blocks the validator may miss per window without being jailed
+func NewValidatorUptime(info ValidatorSigningInfo, window, maxMissed, height int64) ValidatorUptime {
+	counted := info.IndexOffset
+	if counted > window {
+		counted = window
+	}
+	uptime := sdk.OneDec()
+	if counted > 0 {
+		uptime = sdk.NewDec(counted - info.MissedBlocksCounter).QuoInt64(counted)
+	}
+	return ValidatorUptime{
+		Address:             info.Address,
+		SignedBlocksWindow:  window,
+		BlocksCounted:       counted,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+		Uptime:              uptime,
+		ProjectedJailHeight: projectJailHeight(info, counted, window, maxMissed, height),
+	}
+}
+
+// projects the height at which the validator will be jailed for downtime if it keeps missing blocks at its current rate
+// NOTE: returns 0 if the validator is tombstoned, never missed a block, or the rate stays under the threshold
+func projectJailHeight(info ValidatorSigningInfo, counted, window, maxMissed, height int64) int64 {
+	if info.Tombstoned || info.MissedBlocksCounter == 0 || counted == 0 {
+		return 0
+	}
+	// the validator can't be jailed before a full window has passed since it started
+	minHeight := info.StartHeight + window + 1
+	if info.MissedBlocksCounter > maxMissed {
+		return max64(height+1, minHeight)
+	}
+	// at a steady rate the counter converges to rate * window
+	if info.MissedBlocksCounter*window <= maxMissed*counted {
+		return 0
+	}
+	// blocks needed to miss the remaining allowance at the current rate (rounded up)
+	remaining := maxMissed + 1 - info.MissedBlocksCounter
+	blocks := (remaining*counted + info.MissedBlocksCounter - 1) / info.MissedBlocksCounter
+	return max64(height+blocks, minHeight)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// String returns a human readable string representation of a validator uptime.
+func (u ValidatorUptime) String() string {
+	return fmt.Sprintf(`Validator Uptime:
+  Address:               %s
+  Signed Blocks Window:  %d
+  Blocks Counted:        %d
+  Missed Blocks Counter: %d
+  Uptime:                %s
+  Projected Jail Height: %d`,
+		u.Address, u.SignedBlocksWindow, u.BlocksCounted, u.MissedBlocksCounter, u.Uptime, u.ProjectedJailHeight)
+}
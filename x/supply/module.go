@@ -2,8 +2,6 @@ package supply
 
 import (
 	"encoding/json"
-	"github.com/pokt-network/posmint/crypto/keys"
-	"github.com/tendermint/tendermint/node"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -49,20 +47,16 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 // app module
 type AppModule struct {
 	AppModuleBasic
-	keeper  Keeper
-	ak      types.AccountKeeper
-	node    *node.Node
-	keybase keys.Keybase
+	keeper Keeper
+	ak     types.AccountKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, ak types.AccountKeeper, node *node.Node, keybase keys.Keybase) AppModule {
+func NewAppModule(keeper Keeper, ak types.AccountKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		ak:             ak,
-		node:           node,
-		keybase:        keybase,
 	}
 }
 
@@ -71,14 +65,6 @@ func (AppModule) Name() string {
 	return ModuleName
 }
 
-func (am AppModule) GetTendermintNode() *node.Node {
-	return am.node
-}
-
-func (am AppModule) GetKeybase() keys.Keybase {
-	return am.keybase
-}
-
 // register invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	RegisterInvariants(ir, am.keeper)
@@ -116,6 +102,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(gs)
 }
 
+// ConsensusVersion returns the version of the supply module state.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // module begin-block
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 